@@ -0,0 +1,38 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/*
+* Health Funcs
+ */
+
+// CheckHealth returns an error when the API server is not ready or a started informer has not synced its cache
+func (km *KubeManager) CheckHealth(ctx context.Context) error {
+	if km.Closed() {
+		return errClosed
+	}
+
+	if _, err := km.client.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+		return fmt.Errorf("api server unreachable: %v", err)
+	}
+
+	// a closed stop channel makes WaitForCacheSync report the current state instead of blocking
+	snapshot := make(chan struct{})
+	close(snapshot)
+	var unsynced []string
+	for informerType, synced := range km.informers.WaitForCacheSync(snapshot) {
+		if !synced {
+			unsynced = append(unsynced, informerType.String())
+		}
+	}
+	if len(unsynced) > 0 {
+		sort.Strings(unsynced)
+		return fmt.Errorf("informer caches not synced: %s", strings.Join(unsynced, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package manager
+
+import (
+	"context"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckHealth(t *testing.T) {
+	ready := true
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/readyz" || !ready {
+			http.Error(w, "not ready", http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer apiServer.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{
+		client:    client,
+		informers: informers.NewSharedInformerFactory(client, 0),
+		stopCh:    make(chan struct{}),
+	}
+
+	if err := km.CheckHealth(context.Background()); err != nil {
+		t.Errorf("expected healthy, got %v", err)
+	}
+
+	ready = false
+	if err := km.CheckHealth(context.Background()); err == nil {
+		t.Errorf("expected unreachable api server")
+	}
+
+	ready = true
+	km.Close()
+	if err := km.CheckHealth(context.Background()); err == nil {
+		t.Errorf("expected closed manager to be unhealthy")
+	}
+}
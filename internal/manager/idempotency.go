@@ -0,0 +1,100 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strings"
+	"time"
+)
+
+const (
+	// IdempotencyKeyLabel selects the Jobs created for an idempotency key, its value is a hash of the key
+	IdempotencyKeyLabel = "k8s-sidecar.tlantic.com/idempotency-key"
+	// IdempotencyKeyAnnotation holds the idempotency key a Job was created for
+	IdempotencyKeyAnnotation = "k8s-sidecar.tlantic.com/idempotency-key"
+	// DefaultIdempotencyWindow is how long an idempotency key is honoured when no window is configured
+	DefaultIdempotencyWindow = 10 * time.Minute
+)
+
+/*
+* Idempotency Funcs
+ */
+
+// SetIdempotencyKey records key on job so that resubmissions can find it
+func SetIdempotencyKey(job *batchv1.Job, key string) {
+	metav1.SetMetaDataLabel(&job.ObjectMeta, IdempotencyKeyLabel, idempotencyHash(key))
+	metav1.SetMetaDataAnnotation(&job.ObjectMeta, IdempotencyKeyAnnotation, key)
+}
+
+// CreateIdempotentJob creates the index-th Job of a submission for key, unless one was already created
+// for key within the idempotency window, which is then returned with existed set.
+// The Job is named after the key, its index and the current window epoch, so a concurrent or repeated
+// submission collides with AlreadyExists instead of creating a duplicate. A Job of the previous epoch
+// may still be within the window and is looked up first, once the window has passed a new Job is created.
+func (km *KubeManager) CreateIdempotentJob(ctx context.Context, job *batchv1.Job, key string, index int, dryRun bool) (_ *batchv1.Job, existed bool, err error) {
+	ctx, span := km.startSpan(ctx, "CreateIdempotentJob", attribute.Int("k8s.job.index", index), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	now := time.Now()
+	since := now.Add(-km.idempotencyWindow)
+	epoch := now.UnixNano() / int64(km.idempotencyWindow)
+	base := job.Name
+	if base == "" {
+		base = strings.TrimSuffix(job.GenerateName, "-")
+	}
+
+	previous, err := km.GetJob(ctx, idempotentName(base, key, epoch-1, index))
+	if err == nil && idempotentJob(previous, key, since) {
+		return previous, true, nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	SetIdempotencyKey(job, key)
+	job.Name = idempotentName(base, key, epoch, index)
+	job.GenerateName = ""
+	span.SetAttributes(attribute.String("k8s.job.name", job.Name))
+	created, err := km.CreateJob(ctx, job, dryRun)
+	if !apierrors.IsAlreadyExists(err) {
+		return created, false, err
+	}
+
+	found, getErr := km.GetJob(ctx, job.Name)
+	if getErr != nil {
+		return nil, false, getErr
+	}
+	if !idempotentJob(found, key, since) {
+		// the name is taken by a Job of a colliding key
+		return nil, false, err
+	}
+	return found, true, nil
+}
+
+// idempotentName names the index-th Job created for key in a window epoch after base,
+// short enough to fit the job-name label of the pods
+func idempotentName(base, key string, epoch int64, index int) string {
+	suffix := fmt.Sprintf("-%s-%d", idempotencyHash(fmt.Sprintf("%s/%d", key, epoch))[:10], index)
+	if len(base)+len(suffix) > validation.DNS1123LabelMaxLength {
+		base = base[:validation.DNS1123LabelMaxLength-len(suffix)]
+	}
+	return strings.TrimPrefix(base+suffix, "-")
+}
+
+// idempotentJob reports whether job was created for key since the given time, guarding against hash collisions
+func idempotentJob(job *batchv1.Job, key string, since time.Time) bool {
+	return job.Annotations[IdempotencyKeyAnnotation] == key && !job.CreationTimestamp.Time.Before(since)
+}
+
+// idempotencyHash turns any key into a valid label value
+func idempotencyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])[:validation.LabelValueMaxLength]
+}
@@ -0,0 +1,203 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIdempotentName(t *testing.T) {
+	name := idempotentName(strings.Repeat("a", 60), "order-42", 7, 1)
+	if errs := validation.IsDNS1123Label(name); len(errs) != 0 || !strings.HasSuffix(name, "-1") {
+		t.Errorf("invalid name %q: %v", name, errs)
+	}
+	for _, other := range []string{
+		idempotentName(strings.Repeat("a", 60), "order-43", 7, 1),
+		idempotentName(strings.Repeat("a", 60), "order-42", 8, 1),
+		idempotentName(strings.Repeat("a", 60), "order-42", 7, 2),
+	} {
+		if other == name {
+			t.Errorf("expected distinct names per key, epoch and index, got %q twice", name)
+		}
+	}
+	if name := idempotentName("", "order-42", 7, 0); len(validation.IsDNS1123Label(name)) != 0 {
+		t.Errorf("invalid name without a base %q", name)
+	}
+}
+
+func TestIdempotentJob(t *testing.T) {
+	now := time.Date(2021, 5, 4, 3, 0, 0, 0, time.UTC)
+	newJob := func(key string, age time.Duration) *batchv1.Job {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name:              "import",
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}}
+		SetIdempotencyKey(job, key)
+		return job
+	}
+	if errs := validation.IsValidLabelValue(newJob("order-42", 0).Labels[IdempotencyKeyLabel]); len(errs) != 0 {
+		t.Errorf("invalid label value: %v", errs)
+	}
+
+	since := now.Add(-DefaultIdempotencyWindow)
+	if !idempotentJob(newJob("order-42", time.Minute), "order-42", since) {
+		t.Error("expected a recent job of the key to match")
+	}
+	if idempotentJob(newJob("order-42", time.Hour), "order-42", since) {
+		t.Error("expected a job outside the window not to match")
+	}
+	if idempotentJob(newJob("order-43", time.Minute), "order-42", since) {
+		t.Error("expected a job of another key not to match")
+	}
+}
+
+// fakeJobs serves the create and get calls of the Jobs API from stored, rejecting the next failures creates
+type fakeJobs struct {
+	stored   map[string]*batchv1.Job
+	failures int
+}
+
+func (f *fakeJobs) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/apis/batch/v1/namespaces/ns/jobs"
+	w.Header().Set("Content-Type", "application/json")
+	reject := func(code int, err apierrors.APIStatus) {
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(err.Status())
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == prefix:
+		var job batchv1.Job
+		if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+			reject(http.StatusBadRequest, apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		if _, ok := f.stored[job.Name]; ok {
+			reject(http.StatusConflict, apierrors.NewAlreadyExists(batchv1.Resource("jobs"), job.Name))
+			return
+		}
+		if f.failures > 0 {
+			f.failures--
+			reject(http.StatusForbidden, apierrors.NewForbidden(batchv1.Resource("jobs"), job.Name, errors.New("quota exceeded")))
+			return
+		}
+		job.CreationTimestamp = metav1.Now()
+		f.stored[job.Name] = &job
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(&job)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, prefix+"/"):
+		name := strings.TrimPrefix(r.URL.Path, prefix+"/")
+		job, ok := f.stored[name]
+		if !ok {
+			reject(http.StatusNotFound, apierrors.NewNotFound(batchv1.Resource("jobs"), name))
+			return
+		}
+		json.NewEncoder(w).Encode(job)
+	default:
+		reject(http.StatusMethodNotAllowed, apierrors.NewMethodNotSupported(batchv1.Resource("jobs"), r.Method))
+	}
+}
+
+func TestCreateIdempotentJob(t *testing.T) {
+	const window = time.Hour
+	jobs := &fakeJobs{}
+	apiServer := httptest.NewServer(jobs)
+	defer apiServer.Close()
+
+	// every submission makes a few calls, do not throttle them
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL, QPS: 1000, Burst: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{client: client, namespace: "ns", idempotencyWindow: window}
+
+	// submit creates the documents of a submission in order, the way CreateJob does, and reports
+	// which of them already existed
+	submit := func(documents ...string) ([]string, []bool, error) {
+		var names []string
+		var existing []bool
+		for index, document := range documents {
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: document}}
+			created, existed, err := km.CreateIdempotentJob(context.Background(), job, "order-42", index, false)
+			if err != nil {
+				return names, existing, err
+			}
+			names = append(names, created.Name)
+			existing = append(existing, existed)
+		}
+		return names, existing, nil
+	}
+	previousName := func(base string, index int) string {
+		return idempotentName(base, "order-42", time.Now().UnixNano()/int64(window)-1, index)
+	}
+	storePrevious := func(base string, age time.Duration) string {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name:              previousName(base, 0),
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		}}
+		SetIdempotencyKey(job, "order-42")
+		jobs.stored[job.Name] = job
+		return job.Name
+	}
+
+	t.Run("Resubmission", func(t *testing.T) {
+		jobs.stored = make(map[string]*batchv1.Job)
+		first, existing, err := submit("import")
+		if err != nil || existing[0] {
+			t.Fatalf("expected a new job, got %v %v", existing, err)
+		}
+		again, existing, err := submit("import")
+		if err != nil || !existing[0] || again[0] != first[0] {
+			t.Errorf("expected the existing job %q, got %v %v %v", first[0], again, existing, err)
+		}
+	})
+
+	t.Run("RetryAfterPartialFailure", func(t *testing.T) {
+		jobs.stored = make(map[string]*batchv1.Job)
+		// the second Job is rejected, leaving a partial set behind
+		if _, _, err := submit("import"); err != nil {
+			t.Fatal(err)
+		}
+		jobs.failures = 1
+		if _, _, err := submit("import", "report"); !apierrors.IsForbidden(err) {
+			t.Fatalf("expected the second job to fail, got %v", err)
+		}
+
+		names, existing, err := submit("import", "report")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs.stored) != 2 || !existing[0] || existing[1] {
+			t.Errorf("expected the missing job to be created, got %v %v with %d jobs", names, existing, len(jobs.stored))
+		}
+	})
+
+	t.Run("PreviousEpoch", func(t *testing.T) {
+		jobs.stored = make(map[string]*batchv1.Job)
+		name := storePrevious("import", time.Minute)
+		names, existing, err := submit("import")
+		if err != nil || !existing[0] || names[0] != name {
+			t.Errorf("expected the job %q of the previous epoch, got %v %v %v", name, names, existing, err)
+		}
+	})
+
+	t.Run("WindowPassed", func(t *testing.T) {
+		jobs.stored = make(map[string]*batchv1.Job)
+		name := storePrevious("import", 2*window)
+		names, existing, err := submit("import")
+		if err != nil || existing[0] || names[0] == name {
+			t.Errorf("expected a new job, got %v %v %v", names, existing, err)
+		}
+	})
+}
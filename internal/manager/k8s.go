@@ -2,31 +2,60 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
-	informercorev1 "k8s.io/client-go/informers/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
-	"strings"
+	watchtools "k8s.io/client-go/tools/watch"
+	"sync"
 	"time"
 )
 
 // KubeManager ...
 type KubeManager struct {
 	client    *kubernetes.Clientset
-	jobs      map[string]*batchv1.Job
 	namespace string
+
+	allowedSecrets           map[string]struct{}
+	defaultConcurrencyPolicy batchv1.ConcurrencyPolicy
+	templateLabel            string
+	idempotencyWindow        time.Duration
+
+	informers informers.SharedInformerFactory
+	stopCh    chan struct{}
+	closeOnce sync.Once
+
+	configMaps sharedInformer
+	jobs       sharedInformer
+	// secrets holds an informer per allowed Secret name, so watches only need access to those Secrets
+	secretsMu sync.Mutex
+	secrets   map[string]*sharedInformer
 }
 
 type KubeManagerOptions struct {
 	Config    string
 	Namespace string
 	Timeout   int
+	// AllowedSecrets lists the Secret names the sidecar may serve, none are served when empty
+	AllowedSecrets []string
+	// DefaultConcurrencyPolicy applies to created CronJobs whose template has no policy, the API default when empty
+	DefaultConcurrencyPolicy string
+	// TemplateLabel marks the ConfigMaps holding Job templates, DefaultTemplateLabel when empty
+	TemplateLabel string
+	// IdempotencyWindow is how long CreateJob idempotency keys are honoured, DefaultIdempotencyWindow when zero
+	IdempotencyWindow time.Duration
 }
 
 // NewKube ...
@@ -34,6 +63,25 @@ func NewKube(options *KubeManagerOptions) (*KubeManager, error) {
 	var err error
 	k := new(KubeManager)
 	k.namespace = options.Namespace
+	k.allowedSecrets = make(map[string]struct{}, len(options.AllowedSecrets))
+	for _, name := range options.AllowedSecrets {
+		k.allowedSecrets[name] = struct{}{}
+	}
+
+	k.defaultConcurrencyPolicy = batchv1.ConcurrencyPolicy(options.DefaultConcurrencyPolicy)
+	if k.defaultConcurrencyPolicy != "" && !ValidConcurrencyPolicy(k.defaultConcurrencyPolicy) {
+		return nil, fmt.Errorf("invalid default concurrency policy %q", options.DefaultConcurrencyPolicy)
+	}
+
+	k.templateLabel = options.TemplateLabel
+	if k.templateLabel == "" {
+		k.templateLabel = DefaultTemplateLabel
+	}
+
+	k.idempotencyWindow = options.IdempotencyWindow
+	if k.idempotencyWindow <= 0 {
+		k.idempotencyWindow = DefaultIdempotencyWindow
+	}
 
 	k.client, err = newKubeClientSet(options.Config, options.Timeout)
 
@@ -41,9 +89,39 @@ func NewKube(options *KubeManagerOptions) (*KubeManager, error) {
 		return nil, err
 	}
 
+	k.stopCh = make(chan struct{})
+	k.informers = informers.NewSharedInformerFactoryWithOptions(k.client, 0, informers.WithNamespace(k.namespace))
+
 	return k, nil
 }
 
+// Close stops the shared informers and ends every watch subscription, watches started afterwards fail.
+// It is safe to call Close more than once.
+func (km *KubeManager) Close() {
+	km.closeOnce.Do(func() {
+		close(km.stopCh)
+		for _, resource := range km.sharedInformers() {
+			for _, shared := range resource {
+				// wait for a concurrent first watch to finish starting the informer
+				shared.once.Do(func() {})
+				if shared.broadcaster != nil {
+					shared.broadcaster.close()
+				}
+			}
+		}
+	})
+}
+
+// Closed reports whether Close was called
+func (km *KubeManager) Closed() bool {
+	select {
+	case <-km.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
 // NewKubeClientSet creates and initializes a Kubernetes API client to manage our jobs
 func newKubeClientSet(kubeConfig string, kubeTimeout int) (*kubernetes.Clientset, error) {
 	var err error
@@ -66,28 +144,94 @@ func newKubeClientSet(kubeConfig string, kubeTimeout int) (*kubernetes.Clientset
 	return client, nil
 }
 
-func (km *KubeManager) GetConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error) {
+// dryRunOption asks the API server to validate and default a request without persisting it
+func dryRunOption(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+func (km *KubeManager) GetConfigMap(ctx context.Context, name string) (_ *v1.ConfigMap, err error) {
+	ctx, span := km.startSpan(ctx, "GetConfigMap", attribute.String("k8s.configmap.name", name))
+	defer func() { endSpan(span, err) }()
+
 	cfgMap, err := km.client.CoreV1().ConfigMaps(km.namespace).Get(ctx, name, metav1.GetOptions{})
 	return cfgMap, err
 
 }
 
-func (km *KubeManager) Watch(keys []string, ch chan string, secretInformer informercorev1.ConfigMapInformer) {
-	secretInformer.Informer().AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			UpdateFunc: func(oldObj, newObj interface{}) {
-				data := newObj.(*v1.ConfigMap)
-				key, _ := cache.MetaNamespaceKeyFunc(newObj)
+// WatchConfigMaps returns the current state of the named ConfigMaps that exist and streams their add,
+// update and delete events from that state on until ctx is done.
+// The underlying informer is shared by every watcher and started on first use.
+func (km *KubeManager) WatchConfigMaps(ctx context.Context, names []string) (_ []*v1.ConfigMap, _ <-chan Event, err error) {
+	ctx, span := km.startSpan(ctx, "WatchConfigMaps", attribute.StringSlice("k8s.configmap.names", names))
+	defer func() { endSpan(span, err) }()
 
-				for _, v := range keys {
-					if fmt.Sprintf("%s/%s", km.namespace, v) == key {
-						ch <- data.Name
-					}
-				}
+	objects, events, err := km.watch(ctx, &km.configMaps, km.informers.Core().V1().ConfigMaps().Informer, names)
+	if err != nil {
+		return nil, nil, err
+	}
+	initial := make([]*v1.ConfigMap, len(objects))
+	for index, object := range objects {
+		initial[index] = object.(*v1.ConfigMap)
+	}
+	return initial, events, nil
+}
+
+// CreateConfigMap ...
+func (km *KubeManager) CreateConfigMap(ctx context.Context, cfgMap *v1.ConfigMap, dryRun bool) (_ *v1.ConfigMap, err error) {
+	ctx, span := km.startSpan(ctx, "CreateConfigMap", attribute.String("k8s.configmap.name", cfgMap.Name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	return km.client.CoreV1().ConfigMaps(km.namespace).Create(ctx, cfgMap, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
+}
+
+// UpdateConfigMap replaces a ConfigMap. A non empty resourceVersion on cfgMap makes the update fail
+// with a conflict when the ConfigMap was changed since it was read.
+func (km *KubeManager) UpdateConfigMap(ctx context.Context, cfgMap *v1.ConfigMap, dryRun bool) (_ *v1.ConfigMap, err error) {
+	ctx, span := km.startSpan(ctx, "UpdateConfigMap", attribute.String("k8s.configmap.name", cfgMap.Name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
 
-			},
-		},
-	)
+	return km.client.CoreV1().ConfigMaps(km.namespace).Update(ctx, cfgMap, metav1.UpdateOptions{DryRun: dryRunOption(dryRun)})
+}
+
+// PatchConfigMapKeys sets and removes individual data keys of a ConfigMap, leaving the other keys untouched.
+// When resourceVersion is not empty the patch is rejected with a conflict if the ConfigMap has changed.
+func (km *KubeManager) PatchConfigMapKeys(ctx context.Context, name string, set map[string]string, remove []string, resourceVersion string, dryRun bool) (_ *v1.ConfigMap, err error) {
+	ctx, span := km.startSpan(ctx, "PatchConfigMapKeys", attribute.String("k8s.configmap.name", name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	data := make(map[string]interface{}, len(set)+len(remove))
+	for _, key := range remove {
+		data[key] = nil
+	}
+	for key, value := range set {
+		data[key] = value
+	}
+
+	patch := map[string]interface{}{"data": data}
+	if resourceVersion != "" {
+		patch["metadata"] = map[string]interface{}{"resourceVersion": resourceVersion}
+	}
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return km.client.CoreV1().ConfigMaps(km.namespace).Patch(ctx, name, types.MergePatchType, body, metav1.PatchOptions{DryRun: dryRunOption(dryRun)})
+}
+
+// DeleteConfigMap deletes a ConfigMap, only if it still has resourceVersion when that is not empty
+func (km *KubeManager) DeleteConfigMap(ctx context.Context, name, resourceVersion string, dryRun bool) (err error) {
+	ctx, span := km.startSpan(ctx, "DeleteConfigMap", attribute.String("k8s.configmap.name", name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	options := metav1.DeleteOptions{DryRun: dryRunOption(dryRun)}
+	if resourceVersion != "" {
+		options.Preconditions = &metav1.Preconditions{ResourceVersion: &resourceVersion}
+	}
+	return km.client.CoreV1().ConfigMaps(km.namespace).Delete(ctx, name, options)
 }
 
 /*
@@ -95,50 +239,218 @@ func (km *KubeManager) Watch(keys []string, ch chan string, secretInformer infor
  */
 
 // GetCronJob ...
-func (km *KubeManager) GetCronJob(ctx context.Context, name string) (*batchv1.CronJob, error) {
+func (km *KubeManager) GetCronJob(ctx context.Context, name string) (_ *batchv1.CronJob, err error) {
+	ctx, span := km.startSpan(ctx, "GetCronJob", attribute.String("k8s.cronjob.name", name))
+	defer func() { endSpan(span, err) }()
+
 	return km.client.BatchV1().CronJobs(km.namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
-// CreateCronJob ...
-func (km *KubeManager) CreateCronJob(ctx context.Context, cronJob *batchv1.CronJob, wait bool) error {
-	cronJob.Spec.ConcurrencyPolicy = batchv1.ReplaceConcurrent
-	if _, err := km.client.BatchV1().CronJobs(km.namespace).Create(ctx, cronJob, metav1.CreateOptions{}); err != nil {
-		return err
-	}
-
-	if wait {
-		return km.WaitForCronJob(ctx, cronJob.Name, km.namespace, 2*time.Minute)
-	}
-
-	return nil
+// CreateCronJob creates a CronJob, applying the default concurrency policy when its template has none
+func (km *KubeManager) CreateCronJob(ctx context.Context, cronJob *batchv1.CronJob, dryRun bool) (_ *batchv1.CronJob, err error) {
+	ctx, span := km.startSpan(ctx, "CreateCronJob", attribute.String("k8s.cronjob.name", cronJob.Name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
 
+	km.defaultConcurrency(cronJob)
+	return km.client.BatchV1().CronJobs(km.namespace).Create(ctx, cronJob, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
 }
 
 // DeleteCronJob ...
-func (km *KubeManager) DeleteCronJob(ctx context.Context, name string) error {
+func (km *KubeManager) DeleteCronJob(ctx context.Context, name string, dryRun bool) (err error) {
+	ctx, span := km.startSpan(ctx, "DeleteCronJob", attribute.String("k8s.cronjob.name", name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
 	policy := metav1.DeletePropagationBackground
 	return km.client.BatchV1().CronJobs(km.namespace).Delete(ctx, name, metav1.DeleteOptions{
 		PropagationPolicy: &policy,
+		DryRun:            dryRunOption(dryRun),
 	})
 }
 
 // ListCronJobs ...
-func (km *KubeManager) ListCronJobs(ctx context.Context) (*batchv1.CronJobList, error) {
+func (km *KubeManager) ListCronJobs(ctx context.Context) (_ *batchv1.CronJobList, err error) {
+	ctx, span := km.startSpan(ctx, "ListCronJobs")
+	defer func() { endSpan(span, err) }()
+
 	return km.client.BatchV1().CronJobs(km.namespace).List(ctx, metav1.ListOptions{})
 }
 
-// WaitForCronJob ...
-func (km *KubeManager) WaitForCronJob(ctx context.Context, name, _ string, timeout time.Duration) error {
-	return wait.Poll(time.Microsecond*10, timeout, func() (bool, error) {
-		job, err := km.GetCronJob(ctx, name)
-		if err != nil {
-			return false, err
+// defaultConcurrency applies the default concurrency policy to cronJob when it has none
+func (km *KubeManager) defaultConcurrency(cronJob *batchv1.CronJob) {
+	if cronJob.Spec.ConcurrencyPolicy == "" {
+		cronJob.Spec.ConcurrencyPolicy = km.defaultConcurrencyPolicy
+	}
+}
+
+// ValidConcurrencyPolicy reports whether policy is one of Allow, Forbid or Replace
+func ValidConcurrencyPolicy(policy batchv1.ConcurrencyPolicy) bool {
+	switch policy {
+	case batchv1.AllowConcurrent, batchv1.ForbidConcurrent, batchv1.ReplaceConcurrent:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReplaceCronJob replaces a CronJob and returns it as it was before and after the update, applying the
+// default concurrency policy when its template has none.
+// The update fails with a conflict when the CronJob no longer has resourceVersion, or changed while
+// being replaced when resourceVersion is empty.
+func (km *KubeManager) ReplaceCronJob(ctx context.Context, cronJob *batchv1.CronJob, resourceVersion string, dryRun bool) (_ *batchv1.CronJob, _ *batchv1.CronJob, err error) {
+	ctx, span := km.startSpan(ctx, "ReplaceCronJob", attribute.String("k8s.cronjob.name", cronJob.Name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	before, err := km.getCronJobVersion(ctx, cronJob.Name, resourceVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	km.defaultConcurrency(cronJob)
+	cronJob.ResourceVersion = before.ResourceVersion
+	after, err := km.client.BatchV1().CronJobs(km.namespace).Update(ctx, cronJob, metav1.UpdateOptions{DryRun: dryRunOption(dryRun)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
+
+// PatchCronJob applies a strategic merge patch to a CronJob and returns it as it was before and after
+// the patch, with the same conflict detection as ReplaceCronJob.
+func (km *KubeManager) PatchCronJob(ctx context.Context, name string, patch []byte, resourceVersion string, dryRun bool) (_ *batchv1.CronJob, _ *batchv1.CronJob, err error) {
+	ctx, span := km.startSpan(ctx, "PatchCronJob", attribute.String("k8s.cronjob.name", name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(patch, &body); err != nil {
+		return nil, nil, apierrors.NewBadRequest(fmt.Sprintf("invalid patch: %v", err))
+	}
+	// an empty patch decodes to null
+	if body == nil {
+		return nil, nil, apierrors.NewBadRequest("invalid patch: expected an object")
+	}
+	metadata, ok := body["metadata"].(map[string]interface{})
+	if !ok && body["metadata"] != nil {
+		return nil, nil, apierrors.NewBadRequest("invalid patch: metadata must be an object")
+	}
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+		body["metadata"] = metadata
+	}
+
+	before, err := km.getCronJobVersion(ctx, name, resourceVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata["resourceVersion"] = before.ResourceVersion
+	if patch, err = json.Marshal(body); err != nil {
+		return nil, nil, err
+	}
+
+	after, err := km.client.BatchV1().CronJobs(km.namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{DryRun: dryRunOption(dryRun)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
+
+// getCronJobVersion reads a CronJob, failing with a conflict when it is not at resourceVersion
+func (km *KubeManager) getCronJobVersion(ctx context.Context, name, resourceVersion string) (*batchv1.CronJob, error) {
+	cronJob, err := km.GetCronJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if resourceVersion != "" && cronJob.ResourceVersion != resourceVersion {
+		return nil, apierrors.NewConflict(batchv1.Resource("cronjobs"), name,
+			fmt.Errorf("resource version %s does not match current version %s", resourceVersion, cronJob.ResourceVersion))
+	}
+	return cronJob, nil
+}
+
+// SetCronJobSuspended sets spec.suspend of a CronJob and returns whether it was suspended before
+func (km *KubeManager) SetCronJobSuspended(ctx context.Context, name string, suspend, dryRun bool) (_ bool, _ *batchv1.CronJob, err error) {
+	ctx, span := km.startSpan(ctx, "SetCronJobSuspended", attribute.String("k8s.cronjob.name", name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
+	cronJob, err := km.GetCronJob(ctx, name)
+	if err != nil {
+		return false, nil, err
+	}
+	previous := cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+
+	// the resourceVersion makes the patch fail if the CronJob changed since previous was read
+	body, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": cronJob.ResourceVersion},
+		"spec":     map[string]interface{}{"suspend": suspend},
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	cronJob, err = km.client.BatchV1().CronJobs(km.namespace).Patch(ctx, name, types.MergePatchType, body, metav1.PatchOptions{DryRun: dryRunOption(dryRun)})
+	if err != nil {
+		return false, nil, err
+	}
+	return previous, cronJob, nil
+}
+
+// CronJobWaitCondition is the point in a CronJob life WaitForCronJob waits for
+type CronJobWaitCondition int
+
+const (
+	// CronJobScheduled is reached once the controller has scheduled the first Job of the CronJob
+	CronJobScheduled CronJobWaitCondition = iota
+	// CronJobJobStarted is reached once the first scheduled Job has started running
+	CronJobJobStarted
+)
+
+// WaitForCronJob watches a CronJob until condition is reached and returns its state at that point.
+// It gives up when ctx is done, returning the context error.
+func (km *KubeManager) WaitForCronJob(ctx context.Context, name string, condition CronJobWaitCondition) (_ *batchv1.CronJob, err error) {
+	ctx, span := km.startSpan(ctx, "WaitForCronJob", attribute.String("k8s.cronjob.name", name))
+	defer func() { endSpan(span, err) }()
+
+	lw := cache.NewListWatchFromClient(km.client.BatchV1().RESTClient(), "cronjobs", km.namespace, fields.OneTermEqualSelector("metadata.name", name))
+	exists := km.existsPrecondition(batchv1.Resource("cronjobs"), name, func() error {
+		_, err := km.client.BatchV1().CronJobs(km.namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	})
+	event, err := watchtools.UntilWithSync(ctx, lw, &batchv1.CronJob{}, exists, func(event watch.Event) (bool, error) {
+		span.AddEvent("watch event", trace.WithAttributes(attribute.String("type", string(event.Type))))
+		if event.Type == watch.Deleted {
+			return false, apierrors.NewNotFound(batchv1.Resource("cronjobs"), name)
 		}
-		if strings.Compare(job.Name, name) != 0 {
+		cronJob, ok := event.Object.(*batchv1.CronJob)
+		if !ok {
 			return false, nil
 		}
-		return true, nil
+		return cronJob.Status.LastScheduleTime != nil || len(cronJob.Status.Active) > 0, nil
 	})
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+	cronJob := event.Object.(*batchv1.CronJob)
+
+	// without active jobs the first one has already started and finished
+	if condition == CronJobScheduled || len(cronJob.Status.Active) == 0 {
+		return cronJob, nil
+	}
+
+	jobName := cronJob.Status.Active[0].Name
+	span.SetAttributes(attribute.String("k8s.job.name", jobName))
+	lw = cache.NewListWatchFromClient(km.client.BatchV1().RESTClient(), "jobs", km.namespace, fields.OneTermEqualSelector("metadata.name", jobName))
+	_, err = watchtools.UntilWithSync(ctx, lw, &batchv1.Job{}, nil, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return true, nil
+		}
+		job, ok := event.Object.(*batchv1.Job)
+		return ok && job.Status.StartTime != nil, nil
+	})
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+
+	return cronJob, nil
 }
 
 /*
@@ -146,48 +458,96 @@ func (km *KubeManager) WaitForCronJob(ctx context.Context, name, _ string, timeo
  */
 
 // GetJob ...
-func (km *KubeManager) GetJob(ctx context.Context, name string) (*batchv1.Job, error) {
+func (km *KubeManager) GetJob(ctx context.Context, name string) (_ *batchv1.Job, err error) {
+	ctx, span := km.startSpan(ctx, "GetJob", attribute.String("k8s.job.name", name))
+	defer func() { endSpan(span, err) }()
+
 	return km.client.BatchV1().Jobs(km.namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
 // ListJobs ...
-func (km *KubeManager) ListJobs(ctx context.Context) (*batchv1.JobList, error) {
+func (km *KubeManager) ListJobs(ctx context.Context) (_ *batchv1.JobList, err error) {
+	ctx, span := km.startSpan(ctx, "ListJobs")
+	defer func() { endSpan(span, err) }()
+
 	return km.client.BatchV1().Jobs(km.namespace).List(ctx, metav1.ListOptions{})
 }
 
 // DeleteJob ...
-func (km *KubeManager) DeleteJob(ctx context.Context, name string) error {
+func (km *KubeManager) DeleteJob(ctx context.Context, name string, dryRun bool) (err error) {
+	ctx, span := km.startSpan(ctx, "DeleteJob", attribute.String("k8s.job.name", name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
+
 	policy := metav1.DeletePropagationBackground
 	return km.client.BatchV1().Jobs(km.namespace).Delete(ctx, name, metav1.DeleteOptions{
 		PropagationPolicy: &policy,
+		DryRun:            dryRunOption(dryRun),
 	})
 }
 
 // CreateJob ...
-func (km *KubeManager) CreateJob(ctx context.Context, job *batchv1.Job, wait bool) error {
-	if _, err := km.client.BatchV1().Jobs(km.namespace).Create(ctx, job, metav1.CreateOptions{}); err != nil {
-		return err
-	}
+func (km *KubeManager) CreateJob(ctx context.Context, job *batchv1.Job, dryRun bool) (_ *batchv1.Job, err error) {
+	ctx, span := km.startSpan(ctx, "CreateJob", attribute.String("k8s.job.name", job.Name), attribute.Bool("k8s.dry_run", dryRun))
+	defer func() { endSpan(span, err) }()
 
-	if wait {
-		return km.WaitForJob(ctx, job.Name, km.namespace, time.Minute)
-	}
+	return km.client.BatchV1().Jobs(km.namespace).Create(ctx, job, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
+}
 
-	return nil
+// WaitForJob watches a job until it has a Complete or Failed condition and returns its final state.
+// It gives up when ctx is done, returning the context error.
+func (km *KubeManager) WaitForJob(ctx context.Context, name string) (_ *batchv1.Job, err error) {
+	ctx, span := km.startSpan(ctx, "WaitForJob", attribute.String("k8s.job.name", name))
+	defer func() { endSpan(span, err) }()
 
-}
+	lw := cache.NewListWatchFromClient(km.client.BatchV1().RESTClient(), "jobs", km.namespace, fields.OneTermEqualSelector("metadata.name", name))
 
-// WaitForJob waits until job deployment has completed
-func (km *KubeManager) WaitForJob(ctx context.Context, name, _ string, timeout time.Duration) error {
-	return wait.Poll(time.Microsecond*5, timeout, func() (bool, error) {
-		job, err := km.GetJob(ctx, name)
-		if err != nil {
-			return false, err
+	exists := km.existsPrecondition(batchv1.Resource("jobs"), name, func() error {
+		_, err := km.client.BatchV1().Jobs(km.namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	})
+	event, err := watchtools.UntilWithSync(ctx, lw, &batchv1.Job{}, exists, func(event watch.Event) (bool, error) {
+		span.AddEvent("watch event", trace.WithAttributes(attribute.String("type", string(event.Type))))
+		if event.Type == watch.Deleted {
+			return false, apierrors.NewNotFound(batchv1.Resource("jobs"), name)
 		}
-		if job.Status.Active == 0 && job.Status.Succeeded == 0 {
+		job, ok := event.Object.(*batchv1.Job)
+		if !ok {
 			return false, nil
 		}
-
-		return true, nil
+		_, finished := JobFinished(job)
+		return finished, nil
 	})
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+
+	return event.Object.(*batchv1.Job), nil
+}
+
+// WatchJobs returns the current state of the named Jobs that exist and streams their add, update
+// and delete events from that state on until ctx is done.
+// The underlying informer is shared by every watcher and started on first use.
+func (km *KubeManager) WatchJobs(ctx context.Context, names []string) (_ []*batchv1.Job, _ <-chan Event, err error) {
+	ctx, span := km.startSpan(ctx, "WatchJobs", attribute.StringSlice("k8s.job.names", names))
+	defer func() { endSpan(span, err) }()
+
+	objects, events, err := km.watch(ctx, &km.jobs, km.informers.Batch().V1().Jobs().Informer, names)
+	if err != nil {
+		return nil, nil, err
+	}
+	initial := make([]*batchv1.Job, len(objects))
+	for index, object := range objects {
+		initial[index] = object.(*batchv1.Job)
+	}
+	return initial, events, nil
+}
+
+// JobFinished reports whether a job has finished and with which condition, Complete or Failed
+func JobFinished(job *batchv1.Job) (batchv1.JobConditionType, bool) {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == v1.ConditionTrue {
+			return condition.Type, true
+		}
+	}
+	return "", false
 }
@@ -0,0 +1,137 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJobFinished(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []batchv1.JobCondition
+		expected   batchv1.JobConditionType
+		finished   bool
+	}{
+		{"Running", nil, "", false},
+		{"Suspended", []batchv1.JobCondition{{Type: batchv1.JobSuspended, Status: v1.ConditionTrue}}, "", false},
+		{"Complete", []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}}, batchv1.JobComplete, true},
+		{"Failed", []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue}}, batchv1.JobFailed, true},
+		{"FailedFalse", []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionFalse}}, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			condition, finished := JobFinished(&batchv1.Job{Status: batchv1.JobStatus{Conditions: test.conditions}})
+			if condition != test.expected || finished != test.finished {
+				t.Errorf("expected (%q, %v), got (%q, %v)", test.expected, test.finished, condition, finished)
+			}
+		})
+	}
+}
+
+func TestDefaultConcurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   batchv1.ConcurrencyPolicy
+		fallback batchv1.ConcurrencyPolicy
+		expected batchv1.ConcurrencyPolicy
+	}{
+		{"TemplateKept", batchv1.AllowConcurrent, batchv1.ForbidConcurrent, batchv1.AllowConcurrent},
+		{"DefaultFillsEmpty", "", batchv1.ForbidConcurrent, batchv1.ForbidConcurrent},
+		{"NoDefault", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			km := &KubeManager{defaultConcurrencyPolicy: test.fallback}
+			cronJob := &batchv1.CronJob{Spec: batchv1.CronJobSpec{ConcurrencyPolicy: test.policy}}
+			km.defaultConcurrency(cronJob)
+			if cronJob.Spec.ConcurrencyPolicy != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, cronJob.Spec.ConcurrencyPolicy)
+			}
+		})
+	}
+}
+
+// laggingCacheAPI serves the named object of the collection at path the way an API server does right
+// after creating it: reads by name see the object, the list served from the watch cache does not yet
+// and the watch then delivers it. Unknown names are not found.
+func laggingCacheAPI(path, listKind, name, object string) *httptest.Server {
+	apiVersion := strings.TrimPrefix(path, "/apis/")
+	apiVersion = apiVersion[:strings.Index(apiVersion, "/namespaces/")]
+	emptyList := fmt.Sprintf(`{"kind":%q,"apiVersion":%q,"metadata":{"resourceVersion":"1"},"items":[]}`, listKind, apiVersion)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == path+"/"+name:
+			fmt.Fprint(w, object)
+		case r.URL.Path != path:
+			http.Error(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`, http.StatusNotFound)
+		case r.URL.Query().Get("watch") != "true":
+			fmt.Fprint(w, emptyList)
+		default:
+			if r.URL.Query().Get("fieldSelector") == "metadata.name="+name {
+				fmt.Fprintf(w, `{"type":"ADDED","object":%s}`+"\n", object)
+			}
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		}
+	}))
+}
+
+func TestWaitForJob(t *testing.T) {
+	apiServer := laggingCacheAPI(testJobsPath, "JobList", "report", fmt.Sprintf(testJob, 2, testJobComplete))
+	defer apiServer.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{client: client, namespace: "ns"}
+
+	job, err := km.WaitForJob(context.Background(), "report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, finished := JobFinished(job); !finished {
+		t.Errorf("expected the finished job, got %v", job.Status)
+	}
+
+	if _, err := km.WaitForJob(context.Background(), "missing"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestWaitForCronJob(t *testing.T) {
+	cronJob := `{"kind":"CronJob","apiVersion":"batch/v1","metadata":{"name":"nightly","namespace":"ns","resourceVersion":"2"},"status":{"lastScheduleTime":"2021-05-04T03:00:00Z"}}`
+	apiServer := laggingCacheAPI("/apis/batch/v1/namespaces/ns/cronjobs", "CronJobList", "nightly", cronJob)
+	defer apiServer.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{client: client, namespace: "ns"}
+
+	scheduled, err := km.WaitForCronJob(context.Background(), "nightly", CronJobScheduled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheduled.Status.LastScheduleTime == nil {
+		t.Errorf("expected the scheduled cron job, got %v", scheduled.Status)
+	}
+
+	if _, err := km.WaitForCronJob(context.Background(), "missing", CronJobScheduled); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package manager
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"sync"
+)
+
+/*
+* Log Funcs
+ */
+
+// JobLogOptions selects which logs of a Job StreamJobLogs reads
+type JobLogOptions struct {
+	// Container to read, every container of the pods when empty
+	Container    string
+	Follow       bool
+	SinceSeconds int64
+	TailLines    int64
+	Previous     bool
+}
+
+// LogLine is a single line printed by a container of a Job pod
+type LogLine struct {
+	Pod       string
+	Container string
+	Text      string
+}
+
+// ListJobPods returns the pods created for the named Job
+func (km *KubeManager) ListJobPods(ctx context.Context, name string) (_ []v1.Pod, err error) {
+	ctx, span := km.startSpan(ctx, "ListJobPods", attribute.String("k8s.job.name", name))
+	defer func() { endSpan(span, err) }()
+
+	job, err := km.GetJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := km.client.CoreV1().Pods(km.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("controller-uid=%s", job.UID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// StreamJobLogs reads the logs of the containers of the named Job's pods concurrently and hands every line to send.
+// Calls to send are serialized and containers that have not started yet are skipped. With Follow the Job's
+// pods are watched: containers are read as they start, including those of pods created later, until the Job
+// has finished and every log has been read.
+func (km *KubeManager) StreamJobLogs(ctx context.Context, name string, options JobLogOptions, send func(LogLine) error) (err error) {
+	ctx, span := km.startSpan(ctx, "StreamJobLogs", attribute.String("k8s.job.name", name), attribute.Bool("k8s.logs.follow", options.Follow))
+	defer func() { endSpan(span, err) }()
+
+	job, err := km.GetJob(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	streams := &logStreams{
+		km:      km,
+		ctx:     ctx,
+		cancel:  cancel,
+		options: options,
+		send:    send,
+		started: make(map[string]struct{}),
+	}
+	selector := fmt.Sprintf("controller-uid=%s", job.UID)
+
+	if options.Follow {
+		if err := km.followJobPods(ctx, name, selector, streams.start); err != nil {
+			streams.fail(err)
+			return streams.wait()
+		}
+	}
+
+	// without Follow this is every log there is, with Follow it catches the pods that reached their
+	// final state after the watch last saw them
+	if ctx.Err() == nil {
+		list, err := km.client.CoreV1().Pods(km.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			streams.fail(err)
+		} else {
+			for index := range list.Items {
+				streams.start(&list.Items[index])
+			}
+		}
+	}
+	return streams.wait()
+}
+
+// followJobPods hands every state of the pods matching selector to start until the named Job has
+// finished, is deleted or ctx is done
+func (km *KubeManager) followJobPods(ctx context.Context, name, selector string, start func(*v1.Pod)) error {
+	initial, jobEvents, err := km.WatchJobs(ctx, []string{name})
+	if err != nil {
+		return err
+	}
+	finished := len(initial) == 0
+	if !finished {
+		_, finished = JobFinished(initial[0])
+	}
+
+	pods := km.client.CoreV1().Pods(km.namespace)
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = selector
+			return pods.List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = selector
+			return pods.Watch(ctx, options)
+		},
+	}, &v1.Pod{}, 0, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			start(obj.(*v1.Pod))
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			start(newObj.(*v1.Pod))
+		},
+	})
+
+	stopCh := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		informer.Run(stopCh)
+	}()
+	// Run returns once the handlers are done, so start is not called after this returns
+	defer func() {
+		close(stopCh)
+		<-stopped
+	}()
+
+	for !finished {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-jobEvents:
+			if !ok {
+				// the Job watch fell behind or the sidecar is shutting down, stop at what is running
+				return nil
+			}
+			if event.Type == watch.Deleted {
+				return nil
+			}
+			_, finished = JobFinished(event.Object.(*batchv1.Job))
+		}
+	}
+	return nil
+}
+
+// logStreams reads the logs of the started containers of Job pods, each container run once
+type logStreams struct {
+	km      *KubeManager
+	ctx     context.Context
+	cancel  context.CancelFunc
+	options JobLogOptions
+	send    func(LogLine) error
+	wg      sync.WaitGroup
+
+	// started holds the container runs being or already read, by pod, container and restart count
+	startedMu sync.Mutex
+	started   map[string]struct{}
+
+	mu       sync.Mutex
+	firstErr error
+}
+
+// start reads the logs of the containers of pod that have started and are not read yet
+func (s *logStreams) start(pod *v1.Pod) {
+	containers := []string{s.options.Container}
+	if s.options.Container == "" {
+		containers = containers[:0]
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+	} else if !hasContainer(pod, s.options.Container) {
+		s.fail(apierrors.NewBadRequest(fmt.Sprintf("container %s is not valid for pod %s", s.options.Container, pod.Name)))
+		return
+	}
+
+	for _, container := range containers {
+		restarts, ready := containerLogsReady(pod, container, s.options.Previous)
+		if !ready {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%d", pod.Name, container, restarts)
+		s.startedMu.Lock()
+		_, ok := s.started[key]
+		s.started[key] = struct{}{}
+		s.startedMu.Unlock()
+		if ok {
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(pod, container, key string) {
+			defer s.wg.Done()
+			err := s.km.streamContainerLogs(s.ctx, pod, container, s.options, s.emit)
+			if err == nil || s.ctx.Err() != nil {
+				return
+			}
+			if s.options.Follow && apierrors.IsBadRequest(err) {
+				// the container went back to waiting before its logs were opened, the next pod update retries it
+				s.startedMu.Lock()
+				delete(s.started, key)
+				s.startedMu.Unlock()
+				return
+			}
+			s.fail(fmt.Errorf("logs of %s/%s: %w", pod, container, err))
+		}(pod.Name, container, key)
+	}
+}
+
+// fail records the first error and stops every stream
+func (s *logStreams) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.firstErr == nil {
+		s.firstErr = err
+		s.cancel()
+	}
+}
+
+func (s *logStreams) emit(line LogLine) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.firstErr != nil {
+		return s.firstErr
+	}
+	return s.send(line)
+}
+
+// wait returns the first error once every stream has ended
+func (s *logStreams) wait() error {
+	s.wg.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.firstErr
+}
+
+func hasContainer(pod *v1.Pod, name string) bool {
+	for _, containers := range [][]v1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			if container.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containerLogsReady reports whether the named container of pod has logs to read, the previous run's
+// when previous is set, and its restart count
+func containerLogsReady(pod *v1.Pod, name string, previous bool) (int32, bool) {
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			if status.Name != name {
+				continue
+			}
+			if previous {
+				return status.RestartCount, status.LastTerminationState.Terminated != nil
+			}
+			return status.RestartCount, status.State.Running != nil || status.State.Terminated != nil
+		}
+	}
+	return 0, false
+}
+
+func (km *KubeManager) streamContainerLogs(ctx context.Context, pod, container string, options JobLogOptions, emit func(LogLine) error) error {
+	logOptions := &v1.PodLogOptions{
+		Container: container,
+		Follow:    options.Follow,
+		Previous:  options.Previous,
+	}
+	if options.SinceSeconds > 0 {
+		logOptions.SinceSeconds = &options.SinceSeconds
+	}
+	if options.TailLines > 0 {
+		logOptions.TailLines = &options.TailLines
+	}
+
+	stream, err := km.client.CoreV1().Pods(km.namespace).GetLogs(pod, logOptions).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if err := emit(LogLine{Pod: pod, Container: container, Text: scanner.Text()}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,135 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testJob         = `{"kind":"Job","apiVersion":"batch/v1","metadata":{"name":"report","namespace":"ns","uid":"1234","resourceVersion":"%d"}%s}`
+	testJobComplete = `,"status":{"conditions":[{"type":"Complete","status":"True"}]}`
+	testPod         = `{"kind":"Pod","apiVersion":"v1","metadata":{"name":%q,"namespace":"ns","resourceVersion":"1","labels":{"controller-uid":"1234"}},"spec":{"containers":[{"name":"main"},{"name":"proxy"}]},"status":{"containerStatuses":[{"name":"main","state":{"running":{}}},{"name":"proxy","state":{"waiting":{"reason":"ContainerCreating"}}}]}}`
+	testJobsPath    = "/apis/batch/v1/namespaces/ns/jobs"
+	testPodsPath    = "/api/v1/namespaces/ns/pods"
+)
+
+// jobLogsAPI serves a Job whose pods run a main container and a proxy container that never starts.
+// Pods named in later are added once the Job's first log is read, the Job completes once they are read.
+func jobLogsAPI(t *testing.T, first string, later ...string) *httptest.Server {
+	var (
+		mu      sync.Mutex
+		pods    = []string{first}
+		read    = make(map[string]bool)
+		added   = make(chan struct{})
+		allRead = make(chan struct{})
+	)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		watching := r.URL.Query().Get("watch") == "true"
+		if watching {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+		}
+
+		switch {
+		case r.URL.Path == testJobsPath+"/report":
+			fmt.Fprintf(w, testJob, 1, "")
+		case r.URL.Path == testJobsPath && !watching:
+			fmt.Fprintf(w, `{"metadata":{"resourceVersion":"1"},"items":[%s]}`, fmt.Sprintf(testJob, 1, ""))
+		case r.URL.Path == testJobsPath:
+			select {
+			case <-allRead:
+				fmt.Fprintf(w, `{"type":"MODIFIED","object":%s}`+"\n", fmt.Sprintf(testJob, 2, testJobComplete))
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+			}
+			<-r.Context().Done()
+		case r.URL.Path == testPodsPath && !watching:
+			mu.Lock()
+			items := make([]string, len(pods))
+			for index, pod := range pods {
+				items[index] = fmt.Sprintf(testPod, pod)
+			}
+			mu.Unlock()
+			fmt.Fprintf(w, `{"metadata":{"resourceVersion":"1"},"items":[%s]}`, strings.Join(items, ","))
+		case r.URL.Path == testPodsPath:
+			select {
+			case <-added:
+				for _, pod := range later {
+					fmt.Fprintf(w, `{"type":"ADDED","object":%s}`+"\n", fmt.Sprintf(testPod, pod))
+				}
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+			}
+			<-r.Context().Done()
+		default:
+			pod := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, testPodsPath+"/"), "/log")
+			if pod == r.URL.Path || r.URL.Query().Get("container") != "main" {
+				t.Errorf("unexpected request %s", r.URL)
+				http.Error(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"BadRequest","code":400}`, http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, "hello from %s\n", pod)
+
+			mu.Lock()
+			defer mu.Unlock()
+			read[pod] = true
+			if pod == first && len(later) > 0 {
+				pods = append(pods, later...)
+				close(added)
+			}
+			if len(read) == 1+len(later) {
+				close(allRead)
+			}
+		}
+	}))
+}
+
+func TestStreamJobLogs(t *testing.T) {
+	for _, follow := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Follow=%t", follow), func(t *testing.T) {
+			var later []string
+			expected := []string{"report-a/main: hello from report-a"}
+			if follow {
+				later = []string{"report-b"}
+				expected = append(expected, "report-b/main: hello from report-b")
+			}
+			apiServer := jobLogsAPI(t, "report-a", later...)
+			defer apiServer.Close()
+
+			client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			km := &KubeManager{
+				client:    client,
+				namespace: "ns",
+				informers: informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithNamespace("ns")),
+				stopCh:    make(chan struct{}),
+			}
+			defer km.Close()
+
+			var lines []string
+			err = km.StreamJobLogs(context.Background(), "report", JobLogOptions{Follow: follow}, func(line LogLine) error {
+				lines = append(lines, fmt.Sprintf("%s/%s: %s", line.Pod, line.Container, line.Text))
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Strings(lines)
+			if strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+				t.Errorf("expected %v, got %v", expected, lines)
+			}
+		})
+	}
+}
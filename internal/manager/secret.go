@@ -0,0 +1,136 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sort"
+)
+
+/*
+* Secret Funcs
+ */
+
+// SecretAllowed reports whether the sidecar is permitted to serve the named Secret
+func (km *KubeManager) SecretAllowed(name string) bool {
+	_, ok := km.allowedSecrets[name]
+	return ok
+}
+
+// GetSecret ...
+func (km *KubeManager) GetSecret(ctx context.Context, name string) (_ *v1.Secret, err error) {
+	ctx, span := km.startSpan(ctx, "GetSecret", attribute.String("k8s.secret.name", name))
+	defer func() { endSpan(span, err) }()
+
+	if !km.SecretAllowed(name) {
+		return nil, secretForbidden(name)
+	}
+	return km.client.CoreV1().Secrets(km.namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListSecrets returns the Secrets in the allow-list that exist in the namespace.
+// Each is read by name, so only those Secrets need to be readable.
+func (km *KubeManager) ListSecrets(ctx context.Context) (_ []v1.Secret, err error) {
+	ctx, span := km.startSpan(ctx, "ListSecrets")
+	defer func() { endSpan(span, err) }()
+
+	secrets := make([]v1.Secret, 0, len(km.allowedSecrets))
+	for _, name := range km.allowedSecretNames() {
+		secret, err := km.client.CoreV1().Secrets(km.namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, *secret)
+	}
+	return secrets, nil
+}
+
+// WatchSecrets returns the current state of the named Secrets that exist and streams their add,
+// update and delete events from that state on until ctx is done.
+// When names is empty every Secret in the allow-list is watched.
+func (km *KubeManager) WatchSecrets(ctx context.Context, names []string) (_ []*v1.Secret, _ <-chan Event, err error) {
+	ctx, span := km.startSpan(ctx, "WatchSecrets", attribute.StringSlice("k8s.secret.names", names))
+	defer func() { endSpan(span, err) }()
+
+	if len(names) == 0 {
+		names = km.allowedSecretNames()
+		if len(names) == 0 {
+			return nil, nil, secretForbidden("")
+		}
+	}
+	for _, name := range names {
+		if !km.SecretAllowed(name) {
+			return nil, nil, secretForbidden(name)
+		}
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	var initial []*v1.Secret
+	sources := make([]<-chan Event, 0, len(names))
+	for _, name := range names {
+		objects, events, err := km.watch(watchCtx, km.secretInformer(name), km.newSecretInformer(name), []string{name})
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+		for _, object := range objects {
+			initial = append(initial, object.(*v1.Secret))
+		}
+		sources = append(sources, events)
+	}
+	return initial, mergeEvents(watchCtx, cancel, sources), nil
+}
+
+// allowedSecretNames returns the allow-list, sorted
+func (km *KubeManager) allowedSecretNames() []string {
+	names := make([]string, 0, len(km.allowedSecrets))
+	for name := range km.allowedSecrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// secretInformer returns the shared informer of the named Secret
+func (km *KubeManager) secretInformer(name string) *sharedInformer {
+	km.secretsMu.Lock()
+	defer km.secretsMu.Unlock()
+	if km.secrets == nil {
+		km.secrets = make(map[string]*sharedInformer)
+	}
+	shared, ok := km.secrets[name]
+	if !ok {
+		shared = new(sharedInformer)
+		km.secrets[name] = shared
+	}
+	return shared
+}
+
+// newSecretInformer builds an informer that lists and watches the named Secret only.
+// It is not part of km.informers, so it is started here and left out of the health check.
+func (km *KubeManager) newSecretInformer(name string) func() cache.SharedIndexInformer {
+	return func() cache.SharedIndexInformer {
+		factory := informers.NewSharedInformerFactoryWithOptions(km.client, 0,
+			informers.WithNamespace(km.namespace),
+			informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+			}),
+		)
+		informer := factory.Core().V1().Secrets().Informer()
+		factory.Start(km.stopCh)
+		return informer
+	}
+}
+
+func secretForbidden(name string) error {
+	return apierrors.NewForbidden(v1.Resource("secrets"), name, fmt.Errorf("secret is not in the sidecar allow-list"))
+}
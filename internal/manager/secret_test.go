@@ -0,0 +1,116 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// secretsAPI serves the named Secrets by name and with a metadata.name field selector only, like an
+// API server whose RBAC grants access to those Secrets alone
+func secretsAPI(t *testing.T, names ...string) *httptest.Server {
+	const prefix = "/api/v1/namespaces/ns/secrets"
+	exists := make(map[string]bool, len(names))
+	for _, name := range names {
+		exists[name] = true
+	}
+	secret := func(name string) string {
+		return fmt.Sprintf(`{"metadata":{"name":%q,"namespace":"ns","resourceVersion":"1"}}`, name)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if name := strings.TrimPrefix(r.URL.Path, prefix+"/"); name != r.URL.Path {
+			if !exists[name] {
+				http.Error(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`, http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, secret(name))
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Query().Get("fieldSelector"), "metadata.name=")
+		if r.URL.Path != prefix || name == r.URL.Query().Get("fieldSelector") {
+			t.Errorf("unexpected request %s", r.URL)
+			http.Error(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`, http.StatusForbidden)
+			return
+		}
+		if r.URL.Query().Get("watch") == "true" {
+			// no changes, hold the watch open until the client stops it
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		items := ""
+		if exists[name] {
+			items = secret(name)
+		}
+		fmt.Fprintf(w, `{"metadata":{"resourceVersion":"1"},"items":[%s]}`, items)
+	}))
+}
+
+func TestListSecrets(t *testing.T) {
+	apiServer := secretsAPI(t, "db")
+	defer apiServer.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{
+		client:         client,
+		namespace:      "ns",
+		allowedSecrets: map[string]struct{}{"db": {}, "missing": {}},
+	}
+
+	secrets, err := km.ListSecrets(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secrets) != 1 || secrets[0].Name != "db" {
+		t.Errorf("expected the db secret only, got %v", secrets)
+	}
+}
+
+func TestWatchSecrets(t *testing.T) {
+	apiServer := secretsAPI(t, "db")
+	defer apiServer.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{
+		client:         client,
+		namespace:      "ns",
+		allowedSecrets: map[string]struct{}{"db": {}, "missing": {}},
+		informers:      informers.NewSharedInformerFactory(client, 0),
+		stopCh:         make(chan struct{}),
+	}
+	defer km.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	initial, events, err := km.WatchSecrets(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(initial) != 1 || initial[0].Name != "db" {
+		t.Errorf("expected the db secret only, got %v", initial)
+	}
+	if stats := km.InformerStats(); len(stats) != 1 || stats[0].Objects != 1 || stats[0].Subscribers != 2 {
+		t.Errorf("unexpected informer stats %+v", stats)
+	}
+
+	cancel()
+	if _, ok := <-events; ok {
+		t.Error("expected no events")
+	}
+}
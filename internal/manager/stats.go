@@ -0,0 +1,52 @@
+package manager
+
+import (
+	"sort"
+)
+
+// InformerStats describes a started shared informer
+type InformerStats struct {
+	Resource    string
+	Objects     int
+	Subscribers int
+}
+
+// sharedInformers returns the shared informers by resource
+func (km *KubeManager) sharedInformers() map[string][]*sharedInformer {
+	km.secretsMu.Lock()
+	defer km.secretsMu.Unlock()
+	secrets := make([]*sharedInformer, 0, len(km.secrets))
+	for _, shared := range km.secrets {
+		secrets = append(secrets, shared)
+	}
+
+	return map[string][]*sharedInformer{
+		"configmaps": {&km.configMaps},
+		"secrets":    secrets,
+		"jobs":       {&km.jobs},
+	}
+}
+
+// InformerStats returns the cache size and watch subscriber count of every started shared informer, by resource
+func (km *KubeManager) InformerStats() []InformerStats {
+	var stats []InformerStats
+	for resource, informers := range km.sharedInformers() {
+		started := false
+		resourceStats := InformerStats{Resource: resource}
+		for _, shared := range informers {
+			if !shared.started.Load() {
+				continue
+			}
+			started = true
+			resourceStats.Objects += len(shared.informer.GetStore().ListKeys())
+			resourceStats.Subscribers += shared.broadcaster.count()
+		}
+		if started {
+			stats = append(stats, resourceStats)
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Resource < stats[j].Resource
+	})
+	return stats
+}
@@ -0,0 +1,46 @@
+package manager
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DefaultTemplateLabel is the label marking ConfigMaps that hold Job templates, whatever its value
+const DefaultTemplateLabel = "k8s-sidecar.tlantic.com/job-template"
+
+/*
+* Template Funcs
+ */
+
+// GetJobTemplate returns the ConfigMap holding the named Job template
+func (km *KubeManager) GetJobTemplate(ctx context.Context, name string) (_ *v1.ConfigMap, err error) {
+	ctx, span := km.startSpan(ctx, "GetJobTemplate", attribute.String("k8s.configmap.name", name))
+	defer func() { endSpan(span, err) }()
+
+	cfgMap, err := km.GetConfigMap(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := cfgMap.Labels[km.templateLabel]; !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "jobtemplates"}, name)
+	}
+	return cfgMap, nil
+}
+
+// ListJobTemplates returns every ConfigMap holding a Job template
+func (km *KubeManager) ListJobTemplates(ctx context.Context) (_ []v1.ConfigMap, err error) {
+	ctx, span := km.startSpan(ctx, "ListJobTemplates")
+	defer func() { endSpan(span, err) }()
+
+	list, err := km.client.CoreV1().ConfigMaps(km.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: km.templateLabel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
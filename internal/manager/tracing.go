@@ -0,0 +1,28 @@
+package manager
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans of KubeManager calls
+const tracerName = "github.com/Tlantic/k8s-sidecar/internal/manager"
+
+// startSpan starts the span of a KubeManager call as a child of the span in ctx
+func (km *KubeManager) startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	attributes = append(attributes, attribute.String("k8s.namespace.name", km.namespace))
+	// resolved on every call so a tracer provider set after startup is used
+	return otel.Tracer(tracerName).Start(ctx, "KubeManager."+name, trace.WithAttributes(attributes...))
+}
+
+// endSpan records err on span, if any, and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
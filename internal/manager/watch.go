@@ -0,0 +1,343 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+// subscriberBuffer is the number of events a subscriber may lag behind before it is dropped
+const subscriberBuffer = 64
+
+// errClosed is returned by watches started after the manager was closed
+var errClosed = apierrors.NewServiceUnavailable("the sidecar is shutting down")
+
+// Event is a change observed by a shared informer
+type Event struct {
+	Type   watch.EventType
+	Object runtime.Object
+}
+
+// sharedInformer lazily starts an informer once per process and fans out its events
+type sharedInformer struct {
+	once        sync.Once
+	informer    cache.SharedIndexInformer
+	broadcaster *broadcaster
+	// started is set once informer and broadcaster may be read without going through once
+	started atomic.Bool
+}
+
+// watch subscribes to the events of the informer built by newInformer, starting it on first use.
+// It returns the cached state of the named objects, or of every object when names is empty, and the
+// events that follow that state. Starting the informer does not block, each caller waits for the
+// cache to sync until ctx is done or the manager is closed. The subscription ends once ctx is done,
+// which closes the returned channel.
+func (km *KubeManager) watch(ctx context.Context, shared *sharedInformer, newInformer func() cache.SharedIndexInformer, names []string) ([]runtime.Object, <-chan Event, error) {
+	if km.Closed() {
+		return nil, nil, errClosed
+	}
+	shared.once.Do(func() {
+		shared.informer = newInformer()
+		shared.broadcaster = newBroadcaster(shared.informer)
+		shared.started.Store(true)
+		km.informers.Start(km.stopCh)
+	})
+	// Close marks the once as done without starting the informer
+	if shared.broadcaster == nil {
+		return nil, nil, errClosed
+	}
+
+	if err := km.waitForSync(ctx, shared.informer); err != nil {
+		return nil, nil, err
+	}
+
+	store := shared.informer.GetStore()
+	initial, ch, cancel := shared.broadcaster.subscribe(names, func() []runtime.Object {
+		return km.snapshot(store, names)
+	})
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+	return initial, ch, nil
+}
+
+// waitForSync waits for the cache of informer to sync, giving up with the context error once ctx
+// is done or with a ServiceUnavailable error once the manager is closed
+func (km *KubeManager) waitForSync(ctx context.Context, informer cache.SharedIndexInformer) error {
+	if informer.HasSynced() {
+		return nil
+	}
+
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-km.stopCh:
+			cancel()
+		case <-syncCtx.Done():
+		}
+	}()
+
+	if cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return errClosed
+}
+
+// snapshot returns the cached named objects that exist, or every cached object when names is empty
+func (km *KubeManager) snapshot(store cache.Store, names []string) []runtime.Object {
+	var objects []runtime.Object
+	if len(names) == 0 {
+		for _, item := range store.List() {
+			if object, ok := item.(runtime.Object); ok {
+				objects = append(objects, object)
+			}
+		}
+		return objects
+	}
+
+	for _, name := range names {
+		item, found, err := store.GetByKey(fmt.Sprintf("%s/%s", km.namespace, name))
+		if err != nil || !found {
+			continue
+		}
+		if object, ok := item.(runtime.Object); ok {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+type subscriber struct {
+	names map[string]struct{}
+	// pending holds the objects whose snapshot state is ahead of what the broadcaster has published,
+	// by name: events are skipped up to the one publishing the snapshot resource version, or up to
+	// the deletion when the resource version is empty
+	pending map[string]string
+	ch      chan Event
+}
+
+func (sub *subscriber) wants(name string) bool {
+	if len(sub.names) == 0 {
+		return true
+	}
+	_, ok := sub.names[name]
+	return ok
+}
+
+// skip reports whether an event is already part of the snapshot the subscriber started from
+func (sub *subscriber) skip(eventType watch.EventType, name, resourceVersion string) bool {
+	until, ok := sub.pending[name]
+	if !ok {
+		return false
+	}
+	if (eventType == watch.Deleted && until == "") || (eventType != watch.Deleted && resourceVersion == until) {
+		delete(sub.pending, name)
+	}
+	return true
+}
+
+// broadcaster fans out the events of a single shared informer to any number of subscribers.
+// Informer event handlers cannot be removed, so one handler is registered per informer and
+// subscriptions are managed here instead.
+type broadcaster struct {
+	mu   sync.Mutex
+	next int
+	subs map[int]*subscriber
+	// published holds the resource version of every object as of the last published event, by name
+	published map[string]string
+	closed    bool
+}
+
+func newBroadcaster(informer cache.SharedIndexInformer) *broadcaster {
+	b := &broadcaster{
+		subs:      make(map[int]*subscriber),
+		published: make(map[string]string),
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			b.publish(watch.Added, obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			b.publish(watch.Modified, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			b.publish(watch.Deleted, obj)
+		},
+	})
+	return b
+}
+
+// subscribe registers interest in the objects with the given names, or in every object when
+// names is empty. The cache state returned by snapshot, when not nil, is taken atomically with the
+// subscription: events the informer has yet to publish for that state are not sent, so the
+// subscriber sees neither duplicates nor states older than the snapshot.
+// The returned func unsubscribes and must be called once the caller is done.
+func (b *broadcaster) subscribe(names []string, snapshot func() []runtime.Object) ([]runtime.Object, <-chan Event, func()) {
+	sub := &subscriber{
+		names:   make(map[string]struct{}, len(names)),
+		pending: make(map[string]string),
+		ch:      make(chan Event, subscriberBuffer),
+	}
+	for _, name := range names {
+		sub.names[name] = struct{}{}
+	}
+
+	b.mu.Lock()
+	var initial []runtime.Object
+	if snapshot != nil {
+		initial = snapshot()
+		cached := make(map[string]struct{}, len(initial))
+		for _, object := range initial {
+			accessor, err := meta.Accessor(object)
+			if err != nil {
+				continue
+			}
+			cached[accessor.GetName()] = struct{}{}
+			if b.published[accessor.GetName()] != accessor.GetResourceVersion() {
+				sub.pending[accessor.GetName()] = accessor.GetResourceVersion()
+			}
+		}
+		for name := range b.published {
+			if _, ok := cached[name]; !ok && sub.wants(name) {
+				sub.pending[name] = ""
+			}
+		}
+	}
+	id := b.next
+	b.next++
+	if b.closed {
+		close(sub.ch)
+	} else {
+		b.subs[id] = sub
+	}
+	b.mu.Unlock()
+
+	return initial, sub.ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[id]; ok {
+			delete(b.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+func (b *broadcaster) publish(eventType watch.EventType, obj interface{}) {
+	object, ok := obj.(runtime.Object)
+	if !ok {
+		return
+	}
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return
+	}
+	name, resourceVersion := accessor.GetName(), accessor.GetResourceVersion()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if eventType == watch.Deleted {
+		delete(b.published, name)
+	} else {
+		b.published[name] = resourceVersion
+	}
+
+	for id, sub := range b.subs {
+		if !sub.wants(name) || sub.skip(eventType, name, resourceVersion) {
+			continue
+		}
+		select {
+		case sub.ch <- Event{Type: eventType, Object: object}:
+		default:
+			// a stalled subscriber must not block the informer, close it so the caller can resubscribe
+			log.Printf("dropping watch subscriber %d: fell behind on %s", id, name)
+			delete(b.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+// count returns the number of active subscriptions
+func (b *broadcaster) count() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// close ends every subscription and refuses new ones
+func (b *broadcaster) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for id, sub := range b.subs {
+		delete(b.subs, id)
+		close(sub.ch)
+	}
+}
+
+// mergeEvents forwards the events of every source to the returned channel until they close.
+// One source closing ends the merged watch: cancel stops the other sources and the returned
+// channel is closed once all of them are, so the caller resubscribes to the whole set.
+func mergeEvents(ctx context.Context, cancel context.CancelFunc, sources []<-chan Event) <-chan Event {
+	out := make(chan Event, subscriberBuffer)
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func(source <-chan Event) {
+			defer wg.Done()
+			defer cancel()
+			for event := range source {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+				}
+			}
+		}(source)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// existsPrecondition fails a wait with a NotFound error when the named object does not exist.
+// The initial list may come from the API server's watch cache, which can lag behind a create that
+// just happened, so an object missing from it is confirmed with the consistent read of get.
+func (km *KubeManager) existsPrecondition(resource schema.GroupResource, name string, get func() error) watchtools.PreconditionFunc {
+	return func(store cache.Store) (bool, error) {
+		_, found, err := store.GetByKey(fmt.Sprintf("%s/%s", km.namespace, name))
+		if err != nil || found {
+			return false, err
+		}
+		if err := get(); err != nil {
+			return false, err
+		}
+		// the object exists, its event follows the list
+		return false, nil
+	}
+}
+
+// contextError prefers the context error over the generic timeout reported by the watch helpers
+func contextError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
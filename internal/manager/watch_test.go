@@ -0,0 +1,184 @@
+package manager
+
+import (
+	"context"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBroadcaster(t *testing.T) {
+	b := &broadcaster{subs: make(map[int]*subscriber), published: make(map[string]string)}
+
+	t.Run("FiltersByName", func(t *testing.T) {
+		_, ch, cancel := b.subscribe([]string{"wanted"}, nil)
+		defer cancel()
+
+		b.publish(watch.Modified, &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+		b.publish(watch.Modified, &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "wanted"}})
+
+		event := <-ch
+		if name := event.Object.(*v1.ConfigMap).Name; name != "wanted" {
+			t.Errorf("expected event for wanted, got %s", name)
+		}
+		if len(ch) != 0 {
+			t.Errorf("expected no more events, got %d", len(ch))
+		}
+	})
+
+	t.Run("CancelClosesChannel", func(t *testing.T) {
+		_, ch, cancel := b.subscribe(nil, nil)
+		cancel()
+		cancel()
+
+		if _, ok := <-ch; ok {
+			t.Errorf("expected closed channel")
+		}
+	})
+
+	t.Run("CloseEndsSubscriptions", func(t *testing.T) {
+		b := &broadcaster{subs: make(map[int]*subscriber), published: make(map[string]string)}
+		_, before, cancel := b.subscribe(nil, nil)
+		defer cancel()
+
+		b.close()
+		_, after, _ := b.subscribe(nil, nil)
+
+		for _, ch := range []<-chan Event{before, after} {
+			if _, ok := <-ch; ok {
+				t.Errorf("expected closed channel")
+			}
+		}
+	})
+
+	t.Run("SnapshotSkipsPendingEvents", func(t *testing.T) {
+		b := &broadcaster{subs: make(map[int]*subscriber), published: make(map[string]string)}
+		configMap := func(name, resourceVersion string) *v1.ConfigMap {
+			return &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: resourceVersion}}
+		}
+		b.publish(watch.Added, configMap("cm", "1"))
+		b.publish(watch.Added, configMap("gone", "2"))
+
+		// the cache is ahead of the published events: cm was modified and gone deleted
+		initial, ch, cancel := b.subscribe(nil, func() []runtime.Object {
+			return []runtime.Object{configMap("cm", "3")}
+		})
+		defer cancel()
+		if len(initial) != 1 {
+			t.Fatalf("expected the snapshot, got %v", initial)
+		}
+
+		b.publish(watch.Modified, configMap("cm", "3"))
+		b.publish(watch.Deleted, configMap("gone", "2"))
+		b.publish(watch.Modified, configMap("cm", "4"))
+		b.publish(watch.Added, configMap("gone", "5"))
+
+		for _, expected := range []string{"cm@4", "gone@5"} {
+			event := <-ch
+			accessor, _ := meta.Accessor(event.Object)
+			if got := accessor.GetName() + "@" + accessor.GetResourceVersion(); got != expected {
+				t.Errorf("expected %s, got %s", expected, got)
+			}
+		}
+		if len(ch) != 0 {
+			t.Errorf("expected no more events, got %d", len(ch))
+		}
+	})
+
+	t.Run("DropsStalledSubscriber", func(t *testing.T) {
+		_, ch, cancel := b.subscribe(nil, nil)
+		defer cancel()
+
+		for i := 0; i <= subscriberBuffer; i++ {
+			b.publish(watch.Added, &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm"}})
+		}
+
+		count := 0
+		for range ch {
+			count++
+		}
+		if count != subscriberBuffer {
+			t.Errorf("expected %d buffered events, got %d", subscriberBuffer, count)
+		}
+	})
+}
+
+func TestClose(t *testing.T) {
+	km := &KubeManager{stopCh: make(chan struct{})}
+	if km.Closed() {
+		t.Fatalf("expected open manager")
+	}
+
+	km.Close()
+	km.Close()
+	if !km.Closed() {
+		t.Errorf("expected closed manager")
+	}
+	if _, _, err := km.watch(context.Background(), &km.jobs, nil, nil); !apierrors.IsServiceUnavailable(err) {
+		t.Errorf("expected ServiceUnavailable, got %v", err)
+	}
+}
+
+func TestWatchGivesUpWithContext(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`, http.StatusForbidden)
+	}))
+	defer apiServer.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := &KubeManager{
+		client:    client,
+		informers: informers.NewSharedInformerFactory(client, 0),
+		stopCh:    make(chan struct{}),
+	}
+	defer km.Close()
+
+	// the informer never syncs, every watcher gives up on its own deadline
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		start := time.Now()
+		_, _, err := km.WatchConfigMaps(ctx, []string{"cm"})
+		cancel()
+		if err != context.DeadlineExceeded {
+			t.Errorf("expected deadline exceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("watch returned after %s", elapsed)
+		}
+	}
+}
+
+func TestMergeEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	first, second := make(chan Event, 1), make(chan Event, 1)
+	go func() {
+		// stands in for the subscriptions ending once ctx is done
+		<-ctx.Done()
+		close(second)
+	}()
+
+	merged := mergeEvents(ctx, cancel, []<-chan Event{first, second})
+	first <- Event{Type: watch.Added}
+	if event := <-merged; event.Type != watch.Added {
+		t.Errorf("expected the added event, got %v", event)
+	}
+
+	// one source ending ends the merged watch
+	close(first)
+	if _, ok := <-merged; ok {
+		t.Error("expected the merged channel to close")
+	}
+}
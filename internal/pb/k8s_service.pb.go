@@ -8,6 +8,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	context "golang.org/x/net/context"
 	grpc "google.golang.org/grpc"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	math "math"
 )
 
@@ -22,18 +23,238 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type WatchEventType int32
+
+const (
+	WatchEventType_INITIAL  WatchEventType = 0
+	WatchEventType_ADDED    WatchEventType = 1
+	WatchEventType_MODIFIED WatchEventType = 2
+	WatchEventType_DELETED  WatchEventType = 3
+)
+
+var WatchEventType_name = map[int32]string{
+	0: "INITIAL",
+	1: "ADDED",
+	2: "MODIFIED",
+	3: "DELETED",
+}
+
+var WatchEventType_value = map[string]int32{
+	"INITIAL":  0,
+	"ADDED":    1,
+	"MODIFIED": 2,
+	"DELETED":  3,
+}
+
+func (x WatchEventType) String() string {
+	return proto.EnumName(WatchEventType_name, int32(x))
+}
+
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{0}
+}
+
+type TemplateFormat int32
+
+const (
+	// AUTO reads templates starting with '{' as JSON and anything else as YAML
+	TemplateFormat_AUTO TemplateFormat = 0
+	TemplateFormat_JSON TemplateFormat = 1
+	TemplateFormat_YAML TemplateFormat = 2
+)
+
+var TemplateFormat_name = map[int32]string{
+	0: "AUTO",
+	1: "JSON",
+	2: "YAML",
+}
+
+var TemplateFormat_value = map[string]int32{
+	"AUTO": 0,
+	"JSON": 1,
+	"YAML": 2,
+}
+
+func (x TemplateFormat) String() string {
+	return proto.EnumName(TemplateFormat_name, int32(x))
+}
+
+func (TemplateFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{1}
+}
+
+type CronJobWait int32
+
+const (
+	CronJobWait_NO_WAIT CronJobWait = 0
+	// SCHEDULED waits until the controller has scheduled the first Job
+	CronJobWait_SCHEDULED CronJobWait = 1
+	// JOB_STARTED waits until the first scheduled Job has started running
+	CronJobWait_JOB_STARTED CronJobWait = 2
+)
+
+var CronJobWait_name = map[int32]string{
+	0: "NO_WAIT",
+	1: "SCHEDULED",
+	2: "JOB_STARTED",
+}
+
+var CronJobWait_value = map[string]int32{
+	"NO_WAIT":     0,
+	"SCHEDULED":   1,
+	"JOB_STARTED": 2,
+}
+
+func (x CronJobWait) String() string {
+	return proto.EnumName(CronJobWait_name, int32(x))
+}
+
+func (CronJobWait) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{2}
+}
+
+type UpdateStrategy int32
+
+const (
+	// REPLACE stores the template as the new CronJob
+	UpdateStrategy_REPLACE UpdateStrategy = 0
+	// STRATEGIC_MERGE_PATCH merges the template into the stored CronJob
+	UpdateStrategy_STRATEGIC_MERGE_PATCH UpdateStrategy = 1
+)
+
+var UpdateStrategy_name = map[int32]string{
+	0: "REPLACE",
+	1: "STRATEGIC_MERGE_PATCH",
+}
+
+var UpdateStrategy_value = map[string]int32{
+	"REPLACE":               0,
+	"STRATEGIC_MERGE_PATCH": 1,
+}
+
+func (x UpdateStrategy) String() string {
+	return proto.EnumName(UpdateStrategy_name, int32(x))
+}
+
+func (UpdateStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{3}
+}
+
+type JobOutcome int32
+
+const (
+	JobOutcome_UNFINISHED JobOutcome = 0
+	JobOutcome_COMPLETE   JobOutcome = 1
+	JobOutcome_FAILED     JobOutcome = 2
+)
+
+var JobOutcome_name = map[int32]string{
+	0: "UNFINISHED",
+	1: "COMPLETE",
+	2: "FAILED",
+}
+
+var JobOutcome_value = map[string]int32{
+	"UNFINISHED": 0,
+	"COMPLETE":   1,
+	"FAILED":     2,
+}
+
+func (x JobOutcome) String() string {
+	return proto.EnumName(JobOutcome_name, int32(x))
+}
+
+func (JobOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{4}
+}
+
+// ObjectIdentity identifies an object created through the sidecar
+type ObjectIdentity struct {
+	Name                 string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	UID                  string                 `protobuf:"bytes,2,opt,name=UID,proto3" json:"UID,omitempty"`
+	ResourceVersion      string                 `protobuf:"bytes,3,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`
+	CreationTimestamp    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreationTimestamp,proto3" json:"CreationTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ObjectIdentity) Reset()         { *m = ObjectIdentity{} }
+func (m *ObjectIdentity) String() string { return proto.CompactTextString(m) }
+func (*ObjectIdentity) ProtoMessage()    {}
+func (*ObjectIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{0}
+}
+
+func (m *ObjectIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectIdentity.Unmarshal(m, b)
+}
+func (m *ObjectIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectIdentity.Marshal(b, m, deterministic)
+}
+func (m *ObjectIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectIdentity.Merge(m, src)
+}
+func (m *ObjectIdentity) XXX_Size() int {
+	return xxx_messageInfo_ObjectIdentity.Size(m)
+}
+func (m *ObjectIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectIdentity proto.InternalMessageInfo
+
+func (m *ObjectIdentity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ObjectIdentity) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *ObjectIdentity) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *ObjectIdentity) GetCreationTimestamp() *timestamppb.Timestamp {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return nil
+}
+
 type CronJob struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Schedule string `protobuf:"bytes,2,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	// TimeZone is taken from a CRON_TZ or TZ prefix of the schedule, empty means the controller's local time
+	TimeZone             string                 `protobuf:"bytes,3,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	Suspend              bool                   `protobuf:"varint,4,opt,name=Suspend,proto3" json:"Suspend,omitempty"`
+	ConcurrencyPolicy    string                 `protobuf:"bytes,5,opt,name=ConcurrencyPolicy,proto3" json:"ConcurrencyPolicy,omitempty"`
+	LastScheduleTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=LastScheduleTime,proto3" json:"LastScheduleTime,omitempty"`
+	LastSuccessfulTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=LastSuccessfulTime,proto3" json:"LastSuccessfulTime,omitempty"`
+	ActiveJobs           []string               `protobuf:"bytes,8,rep,name=ActiveJobs,proto3" json:"ActiveJobs,omitempty"`
+	Labels               map[string]string      `protobuf:"bytes,9,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations          map[string]string      `protobuf:"bytes,10,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Images               []string               `protobuf:"bytes,11,rep,name=Images,proto3" json:"Images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *CronJob) Reset()         { *m = CronJob{} }
 func (m *CronJob) String() string { return proto.CompactTextString(m) }
 func (*CronJob) ProtoMessage()    {}
 func (*CronJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{0}
+	return fileDescriptor_7903244fefde60d5, []int{1}
 }
 
 func (m *CronJob) XXX_Unmarshal(b []byte) error {
@@ -61,8 +282,159 @@ func (m *CronJob) GetName() string {
 	return ""
 }
 
+func (m *CronJob) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *CronJob) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *CronJob) GetSuspend() bool {
+	if m != nil {
+		return m.Suspend
+	}
+	return false
+}
+
+func (m *CronJob) GetConcurrencyPolicy() string {
+	if m != nil {
+		return m.ConcurrencyPolicy
+	}
+	return ""
+}
+
+func (m *CronJob) GetLastScheduleTime() *timestamppb.Timestamp {
+	if m != nil {
+		return m.LastScheduleTime
+	}
+	return nil
+}
+
+func (m *CronJob) GetLastSuccessfulTime() *timestamppb.Timestamp {
+	if m != nil {
+		return m.LastSuccessfulTime
+	}
+	return nil
+}
+
+func (m *CronJob) GetActiveJobs() []string {
+	if m != nil {
+		return m.ActiveJobs
+	}
+	return nil
+}
+
+func (m *CronJob) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CronJob) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *CronJob) GetImages() []string {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+type ConfigMap struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Data                 map[string]string `protobuf:"bytes,2,rep,name=Data,proto3" json:"Data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BinaryData           map[string][]byte `protobuf:"bytes,3,rep,name=BinaryData,proto3" json:"BinaryData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResourceVersion      string            `protobuf:"bytes,4,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,5,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations          map[string]string `protobuf:"bytes,6,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ConfigMap) Reset()         { *m = ConfigMap{} }
+func (m *ConfigMap) String() string { return proto.CompactTextString(m) }
+func (*ConfigMap) ProtoMessage()    {}
+func (*ConfigMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{2}
+}
+
+func (m *ConfigMap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigMap.Unmarshal(m, b)
+}
+func (m *ConfigMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigMap.Marshal(b, m, deterministic)
+}
+func (m *ConfigMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigMap.Merge(m, src)
+}
+func (m *ConfigMap) XXX_Size() int {
+	return xxx_messageInfo_ConfigMap.Size(m)
+}
+func (m *ConfigMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigMap proto.InternalMessageInfo
+
+func (m *ConfigMap) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConfigMap) GetData() map[string]string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ConfigMap) GetBinaryData() map[string][]byte {
+	if m != nil {
+		return m.BinaryData
+	}
+	return nil
+}
+
+func (m *ConfigMap) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *ConfigMap) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ConfigMap) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 type GetConfigMapRequest struct {
+	// Key selects a single entry of the ConfigMap. When Name is empty it is also the ConfigMap name.
 	Key                  string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -72,7 +444,7 @@ func (m *GetConfigMapRequest) Reset()         { *m = GetConfigMapRequest{} }
 func (m *GetConfigMapRequest) String() string { return proto.CompactTextString(m) }
 func (*GetConfigMapRequest) ProtoMessage()    {}
 func (*GetConfigMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{1}
+	return fileDescriptor_7903244fefde60d5, []int{3}
 }
 
 func (m *GetConfigMapRequest) XXX_Unmarshal(b []byte) error {
@@ -100,18 +472,26 @@ func (m *GetConfigMapRequest) GetKey() string {
 	return ""
 }
 
+func (m *GetConfigMapRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type GetConfigMapResponse struct {
-	Config               string   `protobuf:"bytes,1,opt,name=Config,proto3" json:"Config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Config               string     `protobuf:"bytes,1,opt,name=Config,proto3" json:"Config,omitempty"`
+	ConfigMap            *ConfigMap `protobuf:"bytes,2,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *GetConfigMapResponse) Reset()         { *m = GetConfigMapResponse{} }
 func (m *GetConfigMapResponse) String() string { return proto.CompactTextString(m) }
 func (*GetConfigMapResponse) ProtoMessage()    {}
 func (*GetConfigMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{2}
+	return fileDescriptor_7903244fefde60d5, []int{4}
 }
 
 func (m *GetConfigMapResponse) XXX_Unmarshal(b []byte) error {
@@ -139,553 +519,2680 @@ func (m *GetConfigMapResponse) GetConfig() string {
 	return ""
 }
 
-type GetCronJobsRequest struct {
+func (m *GetConfigMapResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type CreateConfigMapRequest struct {
+	ConfigMap *ConfigMap `protobuf:"bytes,1,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCronJobsRequest) Reset()         { *m = GetCronJobsRequest{} }
-func (m *GetCronJobsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCronJobsRequest) ProtoMessage()    {}
-func (*GetCronJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{3}
+func (m *CreateConfigMapRequest) Reset()         { *m = CreateConfigMapRequest{} }
+func (m *CreateConfigMapRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateConfigMapRequest) ProtoMessage()    {}
+func (*CreateConfigMapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{5}
 }
 
-func (m *GetCronJobsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCronJobsRequest.Unmarshal(m, b)
+func (m *CreateConfigMapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateConfigMapRequest.Unmarshal(m, b)
 }
-func (m *GetCronJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCronJobsRequest.Marshal(b, m, deterministic)
+func (m *CreateConfigMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateConfigMapRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCronJobsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCronJobsRequest.Merge(m, src)
+func (m *CreateConfigMapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateConfigMapRequest.Merge(m, src)
 }
-func (m *GetCronJobsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCronJobsRequest.Size(m)
+func (m *CreateConfigMapRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateConfigMapRequest.Size(m)
 }
-func (m *GetCronJobsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCronJobsRequest.DiscardUnknown(m)
+func (m *CreateConfigMapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateConfigMapRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCronJobsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateConfigMapRequest proto.InternalMessageInfo
 
-type GetCronJobsResponse struct {
-	CronJobs             []*CronJob `protobuf:"bytes,1,rep,name=CronJobs,proto3" json:"CronJobs,omitempty"`
+func (m *CreateConfigMapRequest) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+func (m *CreateConfigMapRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type CreateConfigMapResponse struct {
+	ConfigMap            *ConfigMap `protobuf:"bytes,1,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetCronJobsResponse) Reset()         { *m = GetCronJobsResponse{} }
-func (m *GetCronJobsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCronJobsResponse) ProtoMessage()    {}
-func (*GetCronJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{4}
+func (m *CreateConfigMapResponse) Reset()         { *m = CreateConfigMapResponse{} }
+func (m *CreateConfigMapResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateConfigMapResponse) ProtoMessage()    {}
+func (*CreateConfigMapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{6}
 }
 
-func (m *GetCronJobsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCronJobsResponse.Unmarshal(m, b)
+func (m *CreateConfigMapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateConfigMapResponse.Unmarshal(m, b)
 }
-func (m *GetCronJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCronJobsResponse.Marshal(b, m, deterministic)
+func (m *CreateConfigMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateConfigMapResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCronJobsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCronJobsResponse.Merge(m, src)
+func (m *CreateConfigMapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateConfigMapResponse.Merge(m, src)
 }
-func (m *GetCronJobsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCronJobsResponse.Size(m)
+func (m *CreateConfigMapResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateConfigMapResponse.Size(m)
 }
-func (m *GetCronJobsResponse) XXX_DiscardUnknown() {
+func (m *CreateConfigMapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateConfigMapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateConfigMapResponse proto.InternalMessageInfo
+
+func (m *CreateConfigMapResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type UpdateConfigMapRequest struct {
+	// ConfigMap replaces the stored one. Its ResourceVersion, when set, must match the stored one.
+	ConfigMap *ConfigMap `protobuf:"bytes,1,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateConfigMapRequest) Reset()         { *m = UpdateConfigMapRequest{} }
+func (m *UpdateConfigMapRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigMapRequest) ProtoMessage()    {}
+func (*UpdateConfigMapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{7}
+}
+
+func (m *UpdateConfigMapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateConfigMapRequest.Unmarshal(m, b)
+}
+func (m *UpdateConfigMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateConfigMapRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateConfigMapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigMapRequest.Merge(m, src)
+}
+func (m *UpdateConfigMapRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateConfigMapRequest.Size(m)
+}
+func (m *UpdateConfigMapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigMapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigMapRequest proto.InternalMessageInfo
+
+func (m *UpdateConfigMapRequest) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+func (m *UpdateConfigMapRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type UpdateConfigMapResponse struct {
+	ConfigMap            *ConfigMap `protobuf:"bytes,1,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *UpdateConfigMapResponse) Reset()         { *m = UpdateConfigMapResponse{} }
+func (m *UpdateConfigMapResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigMapResponse) ProtoMessage()    {}
+func (*UpdateConfigMapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{8}
+}
+
+func (m *UpdateConfigMapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateConfigMapResponse.Unmarshal(m, b)
+}
+func (m *UpdateConfigMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateConfigMapResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateConfigMapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigMapResponse.Merge(m, src)
+}
+func (m *UpdateConfigMapResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateConfigMapResponse.Size(m)
+}
+func (m *UpdateConfigMapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigMapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigMapResponse proto.InternalMessageInfo
+
+func (m *UpdateConfigMapResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type PatchConfigMapKeysRequest struct {
+	Name   string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Set    map[string]string `protobuf:"bytes,2,rep,name=Set,proto3" json:"Set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Remove []string          `protobuf:"bytes,3,rep,name=Remove,proto3" json:"Remove,omitempty"`
+	// ResourceVersion, when set, must match the stored one
+	ResourceVersion string `protobuf:"bytes,4,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,5,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PatchConfigMapKeysRequest) Reset()         { *m = PatchConfigMapKeysRequest{} }
+func (m *PatchConfigMapKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*PatchConfigMapKeysRequest) ProtoMessage()    {}
+func (*PatchConfigMapKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{9}
+}
+
+func (m *PatchConfigMapKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PatchConfigMapKeysRequest.Unmarshal(m, b)
+}
+func (m *PatchConfigMapKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PatchConfigMapKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *PatchConfigMapKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchConfigMapKeysRequest.Merge(m, src)
+}
+func (m *PatchConfigMapKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_PatchConfigMapKeysRequest.Size(m)
+}
+func (m *PatchConfigMapKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchConfigMapKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchConfigMapKeysRequest proto.InternalMessageInfo
+
+func (m *PatchConfigMapKeysRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PatchConfigMapKeysRequest) GetSet() map[string]string {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *PatchConfigMapKeysRequest) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+func (m *PatchConfigMapKeysRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *PatchConfigMapKeysRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PatchConfigMapKeysResponse struct {
+	ConfigMap            *ConfigMap `protobuf:"bytes,1,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *PatchConfigMapKeysResponse) Reset()         { *m = PatchConfigMapKeysResponse{} }
+func (m *PatchConfigMapKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*PatchConfigMapKeysResponse) ProtoMessage()    {}
+func (*PatchConfigMapKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{10}
+}
+
+func (m *PatchConfigMapKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PatchConfigMapKeysResponse.Unmarshal(m, b)
+}
+func (m *PatchConfigMapKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PatchConfigMapKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *PatchConfigMapKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchConfigMapKeysResponse.Merge(m, src)
+}
+func (m *PatchConfigMapKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_PatchConfigMapKeysResponse.Size(m)
+}
+func (m *PatchConfigMapKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchConfigMapKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchConfigMapKeysResponse proto.InternalMessageInfo
+
+func (m *PatchConfigMapKeysResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type DeleteConfigMapRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// ResourceVersion, when set, must match the stored one
+	ResourceVersion string `protobuf:"bytes,2,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,3,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteConfigMapRequest) Reset()         { *m = DeleteConfigMapRequest{} }
+func (m *DeleteConfigMapRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteConfigMapRequest) ProtoMessage()    {}
+func (*DeleteConfigMapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{11}
+}
+
+func (m *DeleteConfigMapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteConfigMapRequest.Unmarshal(m, b)
+}
+func (m *DeleteConfigMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteConfigMapRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteConfigMapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConfigMapRequest.Merge(m, src)
+}
+func (m *DeleteConfigMapRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteConfigMapRequest.Size(m)
+}
+func (m *DeleteConfigMapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConfigMapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteConfigMapRequest proto.InternalMessageInfo
+
+func (m *DeleteConfigMapRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteConfigMapRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *DeleteConfigMapRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type DeleteConfigMapResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteConfigMapResponse) Reset()         { *m = DeleteConfigMapResponse{} }
+func (m *DeleteConfigMapResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteConfigMapResponse) ProtoMessage()    {}
+func (*DeleteConfigMapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{12}
+}
+
+func (m *DeleteConfigMapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteConfigMapResponse.Unmarshal(m, b)
+}
+func (m *DeleteConfigMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteConfigMapResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteConfigMapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConfigMapResponse.Merge(m, src)
+}
+func (m *DeleteConfigMapResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteConfigMapResponse.Size(m)
+}
+func (m *DeleteConfigMapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConfigMapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteConfigMapResponse proto.InternalMessageInfo
+
+type WatchConfigMapRequest struct {
+	// Keys are legacy: each is both the ConfigMap name and the entry copied to Config
+	Keys []string `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+	// Names selects the ConfigMaps to watch, Key the entry copied to Config
+	Names                []string `protobuf:"bytes,2,rep,name=Names,proto3" json:"Names,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchConfigMapRequest) Reset()         { *m = WatchConfigMapRequest{} }
+func (m *WatchConfigMapRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchConfigMapRequest) ProtoMessage()    {}
+func (*WatchConfigMapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{13}
+}
+
+func (m *WatchConfigMapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchConfigMapRequest.Unmarshal(m, b)
+}
+func (m *WatchConfigMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchConfigMapRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchConfigMapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchConfigMapRequest.Merge(m, src)
+}
+func (m *WatchConfigMapRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchConfigMapRequest.Size(m)
+}
+func (m *WatchConfigMapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchConfigMapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchConfigMapRequest proto.InternalMessageInfo
+
+func (m *WatchConfigMapRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *WatchConfigMapRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *WatchConfigMapRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type WatchConfigMapResponse struct {
+	Type WatchEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=pb.WatchEventType" json:"Type,omitempty"`
+	// Key is the name of the ConfigMap the event is about
+	Key                  string     `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	Config               string     `protobuf:"bytes,3,opt,name=Config,proto3" json:"Config,omitempty"`
+	ConfigMap            *ConfigMap `protobuf:"bytes,4,opt,name=ConfigMap,proto3" json:"ConfigMap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WatchConfigMapResponse) Reset()         { *m = WatchConfigMapResponse{} }
+func (m *WatchConfigMapResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchConfigMapResponse) ProtoMessage()    {}
+func (*WatchConfigMapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{14}
+}
+
+func (m *WatchConfigMapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchConfigMapResponse.Unmarshal(m, b)
+}
+func (m *WatchConfigMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchConfigMapResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchConfigMapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchConfigMapResponse.Merge(m, src)
+}
+func (m *WatchConfigMapResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchConfigMapResponse.Size(m)
+}
+func (m *WatchConfigMapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchConfigMapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchConfigMapResponse proto.InternalMessageInfo
+
+func (m *WatchConfigMapResponse) GetType() WatchEventType {
+	if m != nil {
+		return m.Type
+	}
+	return WatchEventType_INITIAL
+}
+
+func (m *WatchConfigMapResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *WatchConfigMapResponse) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+func (m *WatchConfigMapResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type Secret struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type                 string            `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Data                 map[string][]byte `protobuf:"bytes,3,rep,name=Data,proto3" json:"Data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResourceVersion      string            `protobuf:"bytes,4,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,5,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations          map[string]string `protobuf:"bytes,6,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Secret) Reset()         { *m = Secret{} }
+func (m *Secret) String() string { return proto.CompactTextString(m) }
+func (*Secret) ProtoMessage()    {}
+func (*Secret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{15}
+}
+
+func (m *Secret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Secret.Unmarshal(m, b)
+}
+func (m *Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Secret.Marshal(b, m, deterministic)
+}
+func (m *Secret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secret.Merge(m, src)
+}
+func (m *Secret) XXX_Size() int {
+	return xxx_messageInfo_Secret.Size(m)
+}
+func (m *Secret) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Secret proto.InternalMessageInfo
+
+func (m *Secret) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Secret) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Secret) GetData() map[string][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Secret) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *Secret) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Secret) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type GetSecretRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSecretRequest) Reset()         { *m = GetSecretRequest{} }
+func (m *GetSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSecretRequest) ProtoMessage()    {}
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{16}
+}
+
+func (m *GetSecretRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSecretRequest.Unmarshal(m, b)
+}
+func (m *GetSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSecretRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSecretRequest.Merge(m, src)
+}
+func (m *GetSecretRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSecretRequest.Size(m)
+}
+func (m *GetSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSecretRequest proto.InternalMessageInfo
+
+func (m *GetSecretRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetSecretResponse struct {
+	Secret               *Secret  `protobuf:"bytes,1,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSecretResponse) Reset()         { *m = GetSecretResponse{} }
+func (m *GetSecretResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSecretResponse) ProtoMessage()    {}
+func (*GetSecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{17}
+}
+
+func (m *GetSecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSecretResponse.Unmarshal(m, b)
+}
+func (m *GetSecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSecretResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSecretResponse.Merge(m, src)
+}
+func (m *GetSecretResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSecretResponse.Size(m)
+}
+func (m *GetSecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSecretResponse proto.InternalMessageInfo
+
+func (m *GetSecretResponse) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type ListSecretsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSecretsRequest) Reset()         { *m = ListSecretsRequest{} }
+func (m *ListSecretsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSecretsRequest) ProtoMessage()    {}
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{18}
+}
+
+func (m *ListSecretsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSecretsRequest.Unmarshal(m, b)
+}
+func (m *ListSecretsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSecretsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSecretsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSecretsRequest.Merge(m, src)
+}
+func (m *ListSecretsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSecretsRequest.Size(m)
+}
+func (m *ListSecretsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSecretsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSecretsRequest proto.InternalMessageInfo
+
+type ListSecretsResponse struct {
+	Secrets              []*Secret `protobuf:"bytes,1,rep,name=Secrets,proto3" json:"Secrets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListSecretsResponse) Reset()         { *m = ListSecretsResponse{} }
+func (m *ListSecretsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSecretsResponse) ProtoMessage()    {}
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{19}
+}
+
+func (m *ListSecretsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSecretsResponse.Unmarshal(m, b)
+}
+func (m *ListSecretsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSecretsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSecretsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSecretsResponse.Merge(m, src)
+}
+func (m *ListSecretsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSecretsResponse.Size(m)
+}
+func (m *ListSecretsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSecretsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSecretsResponse proto.InternalMessageInfo
+
+func (m *ListSecretsResponse) GetSecrets() []*Secret {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+type WatchSecretRequest struct {
+	// Names of the Secrets to watch, every allowed Secret when empty
+	Names                []string `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchSecretRequest) Reset()         { *m = WatchSecretRequest{} }
+func (m *WatchSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchSecretRequest) ProtoMessage()    {}
+func (*WatchSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{20}
+}
+
+func (m *WatchSecretRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchSecretRequest.Unmarshal(m, b)
+}
+func (m *WatchSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchSecretRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchSecretRequest.Merge(m, src)
+}
+func (m *WatchSecretRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchSecretRequest.Size(m)
+}
+func (m *WatchSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchSecretRequest proto.InternalMessageInfo
+
+func (m *WatchSecretRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type WatchSecretResponse struct {
+	Type                 WatchEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=pb.WatchEventType" json:"Type,omitempty"`
+	Secret               *Secret        `protobuf:"bytes,2,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WatchSecretResponse) Reset()         { *m = WatchSecretResponse{} }
+func (m *WatchSecretResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchSecretResponse) ProtoMessage()    {}
+func (*WatchSecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{21}
+}
+
+func (m *WatchSecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchSecretResponse.Unmarshal(m, b)
+}
+func (m *WatchSecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchSecretResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchSecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchSecretResponse.Merge(m, src)
+}
+func (m *WatchSecretResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchSecretResponse.Size(m)
+}
+func (m *WatchSecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchSecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchSecretResponse proto.InternalMessageInfo
+
+func (m *WatchSecretResponse) GetType() WatchEventType {
+	if m != nil {
+		return m.Type
+	}
+	return WatchEventType_INITIAL
+}
+
+func (m *WatchSecretResponse) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type GetCronJobsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCronJobsRequest) Reset()         { *m = GetCronJobsRequest{} }
+func (m *GetCronJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCronJobsRequest) ProtoMessage()    {}
+func (*GetCronJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{22}
+}
+
+func (m *GetCronJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCronJobsRequest.Unmarshal(m, b)
+}
+func (m *GetCronJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCronJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCronJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCronJobsRequest.Merge(m, src)
+}
+func (m *GetCronJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCronJobsRequest.Size(m)
+}
+func (m *GetCronJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCronJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCronJobsRequest proto.InternalMessageInfo
+
+type GetCronJobsResponse struct {
+	CronJobs             []*CronJob `protobuf:"bytes,1,rep,name=CronJobs,proto3" json:"CronJobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetCronJobsResponse) Reset()         { *m = GetCronJobsResponse{} }
+func (m *GetCronJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCronJobsResponse) ProtoMessage()    {}
+func (*GetCronJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{23}
+}
+
+func (m *GetCronJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCronJobsResponse.Unmarshal(m, b)
+}
+func (m *GetCronJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCronJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCronJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCronJobsResponse.Merge(m, src)
+}
+func (m *GetCronJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCronJobsResponse.Size(m)
+}
+func (m *GetCronJobsResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_GetCronJobsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCronJobsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCronJobsResponse proto.InternalMessageInfo
+
+func (m *GetCronJobsResponse) GetCronJobs() []*CronJob {
+	if m != nil {
+		return m.CronJobs
+	}
+	return nil
+}
+
+type GetCronJobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCronJobRequest) Reset()         { *m = GetCronJobRequest{} }
+func (m *GetCronJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCronJobRequest) ProtoMessage()    {}
+func (*GetCronJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{24}
+}
+
+func (m *GetCronJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCronJobRequest.Unmarshal(m, b)
+}
+func (m *GetCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCronJobRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCronJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCronJobRequest.Merge(m, src)
+}
+func (m *GetCronJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCronJobRequest.Size(m)
+}
+func (m *GetCronJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCronJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCronJobRequest proto.InternalMessageInfo
+
+func (m *GetCronJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetCronJobResponse struct {
+	CronJob              *CronJob `protobuf:"bytes,1,opt,name=CronJob,proto3" json:"CronJob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCronJobResponse) Reset()         { *m = GetCronJobResponse{} }
+func (m *GetCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCronJobResponse) ProtoMessage()    {}
+func (*GetCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{25}
+}
+
+func (m *GetCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCronJobResponse.Unmarshal(m, b)
+}
+func (m *GetCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCronJobResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCronJobResponse.Merge(m, src)
+}
+func (m *GetCronJobResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCronJobResponse.Size(m)
+}
+func (m *GetCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCronJobResponse proto.InternalMessageInfo
+
+func (m *GetCronJobResponse) GetCronJob() *CronJob {
+	if m != nil {
+		return m.CronJob
+	}
+	return nil
+}
+
+type CreateCronJobRequest struct {
+	// Template holds one or more CronJob documents, each one is created
+	Template string      `protobuf:"bytes,1,opt,name=Template,proto3" json:"Template,omitempty"`
+	Wait     CronJobWait `protobuf:"varint,2,opt,name=Wait,proto3,enum=pb.CronJobWait" json:"Wait,omitempty"`
+	// WaitTimeoutSeconds bounds the wait, the request deadline applies when zero
+	WaitTimeoutSeconds int64 `protobuf:"varint,3,opt,name=WaitTimeoutSeconds,proto3" json:"WaitTimeoutSeconds,omitempty"`
+	// ConcurrencyPolicy overrides the template policy when set, one of Allow, Forbid or Replace
+	ConcurrencyPolicy string         `protobuf:"bytes,4,opt,name=ConcurrencyPolicy,proto3" json:"ConcurrencyPolicy,omitempty"`
+	Format            TemplateFormat `protobuf:"varint,5,opt,name=Format,proto3,enum=pb.TemplateFormat" json:"Format,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,6,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCronJobRequest) Reset()         { *m = CreateCronJobRequest{} }
+func (m *CreateCronJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCronJobRequest) ProtoMessage()    {}
+func (*CreateCronJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{26}
+}
+
+func (m *CreateCronJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCronJobRequest.Unmarshal(m, b)
+}
+func (m *CreateCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCronJobRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCronJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCronJobRequest.Merge(m, src)
+}
+func (m *CreateCronJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCronJobRequest.Size(m)
+}
+func (m *CreateCronJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCronJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCronJobRequest proto.InternalMessageInfo
+
+func (m *CreateCronJobRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *CreateCronJobRequest) GetWait() CronJobWait {
+	if m != nil {
+		return m.Wait
+	}
+	return CronJobWait_NO_WAIT
+}
+
+func (m *CreateCronJobRequest) GetWaitTimeoutSeconds() int64 {
+	if m != nil {
+		return m.WaitTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *CreateCronJobRequest) GetConcurrencyPolicy() string {
+	if m != nil {
+		return m.ConcurrencyPolicy
+	}
+	return ""
+}
+
+func (m *CreateCronJobRequest) GetFormat() TemplateFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TemplateFormat_AUTO
+}
+
+func (m *CreateCronJobRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type CreateCronJobResponse struct {
+	// CronJob and CronJobs are only set when the request waited, CronJob is the first of CronJobs
+	CronJob  *CronJob   `protobuf:"bytes,1,opt,name=CronJob,proto3" json:"CronJob,omitempty"`
+	CronJobs []*CronJob `protobuf:"bytes,2,rep,name=CronJobs,proto3" json:"CronJobs,omitempty"`
+	// Objects are set on dry runs to the JSON of what the API server would store
+	Objects []string `protobuf:"bytes,3,rep,name=Objects,proto3" json:"Objects,omitempty"`
+	// Created identifies every created CronJob, in template order
+	Created              []*ObjectIdentity `protobuf:"bytes,4,rep,name=Created,proto3" json:"Created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateCronJobResponse) Reset()         { *m = CreateCronJobResponse{} }
+func (m *CreateCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCronJobResponse) ProtoMessage()    {}
+func (*CreateCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{27}
+}
+
+func (m *CreateCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCronJobResponse.Unmarshal(m, b)
+}
+func (m *CreateCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCronJobResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCronJobResponse.Merge(m, src)
+}
+func (m *CreateCronJobResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCronJobResponse.Size(m)
+}
+func (m *CreateCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCronJobResponse proto.InternalMessageInfo
+
+func (m *CreateCronJobResponse) GetCronJob() *CronJob {
+	if m != nil {
+		return m.CronJob
+	}
+	return nil
+}
+
+func (m *CreateCronJobResponse) GetCronJobs() []*CronJob {
+	if m != nil {
+		return m.CronJobs
+	}
+	return nil
+}
+
+func (m *CreateCronJobResponse) GetObjects() []string {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *CreateCronJobResponse) GetCreated() []*ObjectIdentity {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+type TriggerCronJobRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Container receives the overrides, every container of the job template when empty
+	Container string `protobuf:"bytes,2,opt,name=Container,proto3" json:"Container,omitempty"`
+	// Env is set on top of the container environment
+	Env map[string]string `protobuf:"bytes,3,rep,name=Env,proto3" json:"Env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Args replace the container arguments when not empty
+	Args []string `protobuf:"bytes,4,rep,name=Args,proto3" json:"Args,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,5,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerCronJobRequest) Reset()         { *m = TriggerCronJobRequest{} }
+func (m *TriggerCronJobRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerCronJobRequest) ProtoMessage()    {}
+func (*TriggerCronJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{28}
+}
+
+func (m *TriggerCronJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerCronJobRequest.Unmarshal(m, b)
+}
+func (m *TriggerCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerCronJobRequest.Marshal(b, m, deterministic)
+}
+func (m *TriggerCronJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCronJobRequest.Merge(m, src)
+}
+func (m *TriggerCronJobRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerCronJobRequest.Size(m)
+}
+func (m *TriggerCronJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCronJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCronJobRequest proto.InternalMessageInfo
+
+func (m *TriggerCronJobRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TriggerCronJobRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *TriggerCronJobRequest) GetEnv() map[string]string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *TriggerCronJobRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *TriggerCronJobRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type TriggerCronJobResponse struct {
+	JobName string `protobuf:"bytes,1,opt,name=JobName,proto3" json:"JobName,omitempty"`
+	// Object is set on dry runs to the JSON of what the API server would store
+	Object               string          `protobuf:"bytes,2,opt,name=Object,proto3" json:"Object,omitempty"`
+	Created              *ObjectIdentity `protobuf:"bytes,3,opt,name=Created,proto3" json:"Created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TriggerCronJobResponse) Reset()         { *m = TriggerCronJobResponse{} }
+func (m *TriggerCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerCronJobResponse) ProtoMessage()    {}
+func (*TriggerCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{29}
+}
+
+func (m *TriggerCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerCronJobResponse.Unmarshal(m, b)
+}
+func (m *TriggerCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerCronJobResponse.Marshal(b, m, deterministic)
+}
+func (m *TriggerCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCronJobResponse.Merge(m, src)
+}
+func (m *TriggerCronJobResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerCronJobResponse.Size(m)
+}
+func (m *TriggerCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCronJobResponse proto.InternalMessageInfo
+
+func (m *TriggerCronJobResponse) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *TriggerCronJobResponse) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *TriggerCronJobResponse) GetCreated() *ObjectIdentity {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+type UpdateCronJobRequest struct {
+	// Name of the CronJob, taken from the template when empty
+	Name     string         `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Template string         `protobuf:"bytes,2,opt,name=Template,proto3" json:"Template,omitempty"`
+	Strategy UpdateStrategy `protobuf:"varint,3,opt,name=Strategy,proto3,enum=pb.UpdateStrategy" json:"Strategy,omitempty"`
+	// ResourceVersion, when set, must match the stored one
+	ResourceVersion string         `protobuf:"bytes,4,opt,name=ResourceVersion,proto3" json:"ResourceVersion,omitempty"`
+	Format          TemplateFormat `protobuf:"varint,5,opt,name=Format,proto3,enum=pb.TemplateFormat" json:"Format,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,6,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCronJobRequest) Reset()         { *m = UpdateCronJobRequest{} }
+func (m *UpdateCronJobRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCronJobRequest) ProtoMessage()    {}
+func (*UpdateCronJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{30}
+}
+
+func (m *UpdateCronJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCronJobRequest.Unmarshal(m, b)
+}
+func (m *UpdateCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCronJobRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCronJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCronJobRequest.Merge(m, src)
+}
+func (m *UpdateCronJobRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCronJobRequest.Size(m)
+}
+func (m *UpdateCronJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCronJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCronJobRequest proto.InternalMessageInfo
+
+func (m *UpdateCronJobRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCronJobRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *UpdateCronJobRequest) GetStrategy() UpdateStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return UpdateStrategy_REPLACE
+}
+
+func (m *UpdateCronJobRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *UpdateCronJobRequest) GetFormat() TemplateFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TemplateFormat_AUTO
+}
+
+func (m *UpdateCronJobRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type UpdateCronJobResponse struct {
+	CronJob *CronJob `protobuf:"bytes,1,opt,name=CronJob,proto3" json:"CronJob,omitempty"`
+	// Diff is a strategic merge patch of the labels, annotations and spec that changed
+	Diff string `protobuf:"bytes,2,opt,name=Diff,proto3" json:"Diff,omitempty"`
+	// Object is set on dry runs to the JSON of what the API server would store
+	Object               string   `protobuf:"bytes,3,opt,name=Object,proto3" json:"Object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCronJobResponse) Reset()         { *m = UpdateCronJobResponse{} }
+func (m *UpdateCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCronJobResponse) ProtoMessage()    {}
+func (*UpdateCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{31}
+}
+
+func (m *UpdateCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCronJobResponse.Unmarshal(m, b)
+}
+func (m *UpdateCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCronJobResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCronJobResponse.Merge(m, src)
+}
+func (m *UpdateCronJobResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCronJobResponse.Size(m)
+}
+func (m *UpdateCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCronJobResponse proto.InternalMessageInfo
+
+func (m *UpdateCronJobResponse) GetCronJob() *CronJob {
+	if m != nil {
+		return m.CronJob
+	}
+	return nil
+}
+
+func (m *UpdateCronJobResponse) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
+}
+
+func (m *UpdateCronJobResponse) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+type SetCronJobSuspendedRequest struct {
+	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Suspend bool   `protobuf:"varint,2,opt,name=Suspend,proto3" json:"Suspend,omitempty"`
+	// DeleteActiveJobs deletes the Jobs still running when suspending, they are kept otherwise
+	DeleteActiveJobs bool `protobuf:"varint,3,opt,name=DeleteActiveJobs,proto3" json:"DeleteActiveJobs,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,4,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetCronJobSuspendedRequest) Reset()         { *m = SetCronJobSuspendedRequest{} }
+func (m *SetCronJobSuspendedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCronJobSuspendedRequest) ProtoMessage()    {}
+func (*SetCronJobSuspendedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{32}
+}
+
+func (m *SetCronJobSuspendedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCronJobSuspendedRequest.Unmarshal(m, b)
+}
+func (m *SetCronJobSuspendedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCronJobSuspendedRequest.Marshal(b, m, deterministic)
+}
+func (m *SetCronJobSuspendedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCronJobSuspendedRequest.Merge(m, src)
+}
+func (m *SetCronJobSuspendedRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCronJobSuspendedRequest.Size(m)
+}
+func (m *SetCronJobSuspendedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCronJobSuspendedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCronJobSuspendedRequest proto.InternalMessageInfo
+
+func (m *SetCronJobSuspendedRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetCronJobSuspendedRequest) GetSuspend() bool {
+	if m != nil {
+		return m.Suspend
+	}
+	return false
+}
+
+func (m *SetCronJobSuspendedRequest) GetDeleteActiveJobs() bool {
+	if m != nil {
+		return m.DeleteActiveJobs
+	}
+	return false
+}
+
+func (m *SetCronJobSuspendedRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type SetCronJobSuspendedResponse struct {
+	PreviouslySuspended bool     `protobuf:"varint,1,opt,name=PreviouslySuspended,proto3" json:"PreviouslySuspended,omitempty"`
+	CronJob             *CronJob `protobuf:"bytes,2,opt,name=CronJob,proto3" json:"CronJob,omitempty"`
+	DeletedJobs         []string `protobuf:"bytes,3,rep,name=DeletedJobs,proto3" json:"DeletedJobs,omitempty"`
+	// Object is set on dry runs to the JSON of what the API server would store
+	Object               string   `protobuf:"bytes,4,opt,name=Object,proto3" json:"Object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetCronJobSuspendedResponse) Reset()         { *m = SetCronJobSuspendedResponse{} }
+func (m *SetCronJobSuspendedResponse) String() string { return proto.CompactTextString(m) }
+func (*SetCronJobSuspendedResponse) ProtoMessage()    {}
+func (*SetCronJobSuspendedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{33}
+}
+
+func (m *SetCronJobSuspendedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCronJobSuspendedResponse.Unmarshal(m, b)
+}
+func (m *SetCronJobSuspendedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCronJobSuspendedResponse.Marshal(b, m, deterministic)
+}
+func (m *SetCronJobSuspendedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCronJobSuspendedResponse.Merge(m, src)
+}
+func (m *SetCronJobSuspendedResponse) XXX_Size() int {
+	return xxx_messageInfo_SetCronJobSuspendedResponse.Size(m)
+}
+func (m *SetCronJobSuspendedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCronJobSuspendedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCronJobSuspendedResponse proto.InternalMessageInfo
+
+func (m *SetCronJobSuspendedResponse) GetPreviouslySuspended() bool {
+	if m != nil {
+		return m.PreviouslySuspended
+	}
+	return false
+}
+
+func (m *SetCronJobSuspendedResponse) GetCronJob() *CronJob {
+	if m != nil {
+		return m.CronJob
+	}
+	return nil
+}
+
+func (m *SetCronJobSuspendedResponse) GetDeletedJobs() []string {
+	if m != nil {
+		return m.DeletedJobs
+	}
+	return nil
+}
+
+func (m *SetCronJobSuspendedResponse) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+type DeleteCronJobRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCronJobRequest) Reset()         { *m = DeleteCronJobRequest{} }
+func (m *DeleteCronJobRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCronJobRequest) ProtoMessage()    {}
+func (*DeleteCronJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{34}
+}
+
+func (m *DeleteCronJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCronJobRequest.Unmarshal(m, b)
+}
+func (m *DeleteCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCronJobRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCronJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCronJobRequest.Merge(m, src)
+}
+func (m *DeleteCronJobRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCronJobRequest.Size(m)
+}
+func (m *DeleteCronJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCronJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCronJobRequest proto.InternalMessageInfo
+
+func (m *DeleteCronJobRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteCronJobRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type DeleteCronJobResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCronJobResponse) Reset()         { *m = DeleteCronJobResponse{} }
+func (m *DeleteCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCronJobResponse) ProtoMessage()    {}
+func (*DeleteCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{35}
+}
+
+func (m *DeleteCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCronJobResponse.Unmarshal(m, b)
+}
+func (m *DeleteCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCronJobResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCronJobResponse.Merge(m, src)
+}
+func (m *DeleteCronJobResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCronJobResponse.Size(m)
+}
+func (m *DeleteCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCronJobResponse proto.InternalMessageInfo
+
+type JobCondition struct {
+	// Type is one of Complete, Failed or Suspended
+	Type                 string                 `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Status               string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Reason               string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Message              string                 `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	LastTransitionTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=LastTransitionTime,proto3" json:"LastTransitionTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *JobCondition) Reset()         { *m = JobCondition{} }
+func (m *JobCondition) String() string { return proto.CompactTextString(m) }
+func (*JobCondition) ProtoMessage()    {}
+func (*JobCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{36}
+}
+
+func (m *JobCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobCondition.Unmarshal(m, b)
+}
+func (m *JobCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobCondition.Marshal(b, m, deterministic)
+}
+func (m *JobCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCondition.Merge(m, src)
+}
+func (m *JobCondition) XXX_Size() int {
+	return xxx_messageInfo_JobCondition.Size(m)
+}
+func (m *JobCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobCondition proto.InternalMessageInfo
+
+func (m *JobCondition) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *JobCondition) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *JobCondition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobCondition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *JobCondition) GetLastTransitionTime() *timestamppb.Timestamp {
+	if m != nil {
+		return m.LastTransitionTime
+	}
+	return nil
+}
+
+type Job struct {
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Active         int32                  `protobuf:"varint,2,opt,name=Active,proto3" json:"Active,omitempty"`
+	Succeeded      int32                  `protobuf:"varint,3,opt,name=Succeeded,proto3" json:"Succeeded,omitempty"`
+	Failed         int32                  `protobuf:"varint,4,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CompletionTime,proto3" json:"CompletionTime,omitempty"`
+	Conditions     []*JobCondition        `protobuf:"bytes,7,rep,name=Conditions,proto3" json:"Conditions,omitempty"`
+	// CronJob is the name of the owning CronJob, empty when the Job was not scheduled by one
+	CronJob              string   `protobuf:"bytes,8,opt,name=CronJob,proto3" json:"CronJob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{37}
+}
+
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Job) GetActive() int32 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *Job) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *Job) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *Job) GetStartTime() *timestamppb.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Job) GetCompletionTime() *timestamppb.Timestamp {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return nil
+}
+
+func (m *Job) GetConditions() []*JobCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *Job) GetCronJob() string {
+	if m != nil {
+		return m.CronJob
+	}
+	return ""
+}
 
-func (m *GetCronJobsResponse) GetCronJobs() []*CronJob {
+type GetJobsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobsRequest) Reset()         { *m = GetJobsRequest{} }
+func (m *GetJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJobsRequest) ProtoMessage()    {}
+func (*GetJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{38}
+}
+
+func (m *GetJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobsRequest.Unmarshal(m, b)
+}
+func (m *GetJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobsRequest.Merge(m, src)
+}
+func (m *GetJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJobsRequest.Size(m)
+}
+func (m *GetJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobsRequest proto.InternalMessageInfo
+
+type GetJobsResponse struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobsResponse) Reset()         { *m = GetJobsResponse{} }
+func (m *GetJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJobsResponse) ProtoMessage()    {}
+func (*GetJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{39}
+}
+
+func (m *GetJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobsResponse.Unmarshal(m, b)
+}
+func (m *GetJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobsResponse.Merge(m, src)
+}
+func (m *GetJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJobsResponse.Size(m)
+}
+func (m *GetJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobsResponse proto.InternalMessageInfo
+
+func (m *GetJobsResponse) GetJobs() []*Job {
 	if m != nil {
-		return m.CronJobs
+		return m.Jobs
 	}
 	return nil
 }
 
-type GetCronJobRequest struct {
+type GetJobRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCronJobRequest) Reset()         { *m = GetCronJobRequest{} }
-func (m *GetCronJobRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCronJobRequest) ProtoMessage()    {}
-func (*GetCronJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{5}
+func (m *GetJobRequest) Reset()         { *m = GetJobRequest{} }
+func (m *GetJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJobRequest) ProtoMessage()    {}
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{40}
 }
 
-func (m *GetCronJobRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCronJobRequest.Unmarshal(m, b)
+func (m *GetJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobRequest.Unmarshal(m, b)
 }
-func (m *GetCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCronJobRequest.Marshal(b, m, deterministic)
+func (m *GetJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCronJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCronJobRequest.Merge(m, src)
+func (m *GetJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobRequest.Merge(m, src)
 }
-func (m *GetCronJobRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCronJobRequest.Size(m)
+func (m *GetJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJobRequest.Size(m)
+}
+func (m *GetJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobRequest proto.InternalMessageInfo
+
+func (m *GetJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	Job                  *Job     `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobResponse) Reset()         { *m = GetJobResponse{} }
+func (m *GetJobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJobResponse) ProtoMessage()    {}
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{41}
+}
+
+func (m *GetJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobResponse.Unmarshal(m, b)
+}
+func (m *GetJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobResponse.Merge(m, src)
+}
+func (m *GetJobResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJobResponse.Size(m)
+}
+func (m *GetJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobResponse proto.InternalMessageInfo
+
+func (m *GetJobResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type CreateJobRequest struct {
+	// Template holds one or more Job documents, each one is created. Documents may use metadata.generateName,
+	// or set the k8s-sidecar.tlantic.com/name-suffix annotation to "timestamp" or "random" to get a unique
+	// metadata.name. Created holds the final names.
+	Template string `protobuf:"bytes,1,opt,name=Template,proto3" json:"Template,omitempty"`
+	// Wait blocks until the Job has completed or failed
+	Wait bool `protobuf:"varint,2,opt,name=Wait,proto3" json:"Wait,omitempty"`
+	// WaitTimeoutSeconds bounds the wait, the request deadline applies when zero
+	WaitTimeoutSeconds int64          `protobuf:"varint,3,opt,name=WaitTimeoutSeconds,proto3" json:"WaitTimeoutSeconds,omitempty"`
+	Format             TemplateFormat `protobuf:"varint,4,opt,name=Format,proto3,enum=pb.TemplateFormat" json:"Format,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun bool `protobuf:"varint,5,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	// IdempotencyKey, when set, returns the Jobs already created for the same key within the idempotency
+	// window instead of creating new ones. The Jobs are named after the key, replacing any name suffix.
+	IdempotencyKey       string   `protobuf:"bytes,6,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
+func (m *CreateJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateJobRequest) ProtoMessage()    {}
+func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{42}
+}
+
+func (m *CreateJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateJobRequest.Unmarshal(m, b)
+}
+func (m *CreateJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateJobRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateJobRequest.Merge(m, src)
+}
+func (m *CreateJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateJobRequest.Size(m)
+}
+func (m *CreateJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateJobRequest proto.InternalMessageInfo
+
+func (m *CreateJobRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *CreateJobRequest) GetWait() bool {
+	if m != nil {
+		return m.Wait
+	}
+	return false
+}
+
+func (m *CreateJobRequest) GetWaitTimeoutSeconds() int64 {
+	if m != nil {
+		return m.WaitTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *CreateJobRequest) GetFormat() TemplateFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TemplateFormat_AUTO
+}
+
+func (m *CreateJobRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CreateJobRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateJobResponse struct {
+	// Outcome, Job and Jobs are only set when the request waited. Outcome is FAILED when any Job failed
+	// and Job is the first of Jobs.
+	Outcome JobOutcome `protobuf:"varint,1,opt,name=Outcome,proto3,enum=pb.JobOutcome" json:"Outcome,omitempty"`
+	Job     *Job       `protobuf:"bytes,2,opt,name=Job,proto3" json:"Job,omitempty"`
+	Jobs    []*Job     `protobuf:"bytes,3,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	// Objects are set on dry runs to the JSON of what the API server would store
+	Objects []string `protobuf:"bytes,4,rep,name=Objects,proto3" json:"Objects,omitempty"`
+	// Created identifies every created Job, in template order
+	Created []*ObjectIdentity `protobuf:"bytes,5,rep,name=Created,proto3" json:"Created,omitempty"`
+	// Existing is set when every Job was created by an earlier request with the same idempotency key,
+	// a retry after a partial failure creates the missing Jobs only
+	Existing             bool     `protobuf:"varint,6,opt,name=Existing,proto3" json:"Existing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateJobResponse) Reset()         { *m = CreateJobResponse{} }
+func (m *CreateJobResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateJobResponse) ProtoMessage()    {}
+func (*CreateJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{43}
+}
+
+func (m *CreateJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateJobResponse.Unmarshal(m, b)
+}
+func (m *CreateJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateJobResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateJobResponse.Merge(m, src)
+}
+func (m *CreateJobResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateJobResponse.Size(m)
+}
+func (m *CreateJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateJobResponse proto.InternalMessageInfo
+
+func (m *CreateJobResponse) GetOutcome() JobOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return JobOutcome_UNFINISHED
+}
+
+func (m *CreateJobResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *CreateJobResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
 }
-func (m *GetCronJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCronJobRequest.DiscardUnknown(m)
+
+func (m *CreateJobResponse) GetObjects() []string {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetCronJobRequest proto.InternalMessageInfo
+func (m *CreateJobResponse) GetCreated() []*ObjectIdentity {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
 
-func (m *GetCronJobRequest) GetId() string {
+func (m *CreateJobResponse) GetExisting() bool {
 	if m != nil {
-		return m.Id
+		return m.Existing
 	}
-	return ""
+	return false
 }
 
-type GetCronJobResponse struct {
-	CronJob              *CronJob `protobuf:"bytes,1,opt,name=CronJob,proto3" json:"CronJob,omitempty"`
+type TemplateParameter struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Required             bool     `protobuf:"varint,2,opt,name=Required,proto3" json:"Required,omitempty"`
+	Default              string   `protobuf:"bytes,3,opt,name=Default,proto3" json:"Default,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCronJobResponse) Reset()         { *m = GetCronJobResponse{} }
-func (m *GetCronJobResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCronJobResponse) ProtoMessage()    {}
-func (*GetCronJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{6}
+func (m *TemplateParameter) Reset()         { *m = TemplateParameter{} }
+func (m *TemplateParameter) String() string { return proto.CompactTextString(m) }
+func (*TemplateParameter) ProtoMessage()    {}
+func (*TemplateParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{44}
 }
 
-func (m *GetCronJobResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCronJobResponse.Unmarshal(m, b)
+func (m *TemplateParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TemplateParameter.Unmarshal(m, b)
 }
-func (m *GetCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCronJobResponse.Marshal(b, m, deterministic)
+func (m *TemplateParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TemplateParameter.Marshal(b, m, deterministic)
 }
-func (m *GetCronJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCronJobResponse.Merge(m, src)
+func (m *TemplateParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateParameter.Merge(m, src)
 }
-func (m *GetCronJobResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCronJobResponse.Size(m)
+func (m *TemplateParameter) XXX_Size() int {
+	return xxx_messageInfo_TemplateParameter.Size(m)
 }
-func (m *GetCronJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCronJobResponse.DiscardUnknown(m)
+func (m *TemplateParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateParameter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCronJobResponse proto.InternalMessageInfo
+var xxx_messageInfo_TemplateParameter proto.InternalMessageInfo
 
-func (m *GetCronJobResponse) GetCronJob() *CronJob {
+func (m *TemplateParameter) GetName() string {
 	if m != nil {
-		return m.CronJob
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateCronJobRequest struct {
-	Template             string   `protobuf:"bytes,1,opt,name=Template,proto3" json:"Template,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TemplateParameter) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
 }
 
-func (m *CreateCronJobRequest) Reset()         { *m = CreateCronJobRequest{} }
-func (m *CreateCronJobRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCronJobRequest) ProtoMessage()    {}
-func (*CreateCronJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{7}
+func (m *TemplateParameter) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
 }
 
-func (m *CreateCronJobRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCronJobRequest.Unmarshal(m, b)
+func (m *TemplateParameter) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
-func (m *CreateCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCronJobRequest.Marshal(b, m, deterministic)
+
+type JobTemplate struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Parameters           []*TemplateParameter `protobuf:"bytes,2,rep,name=Parameters,proto3" json:"Parameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *CreateCronJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCronJobRequest.Merge(m, src)
+
+func (m *JobTemplate) Reset()         { *m = JobTemplate{} }
+func (m *JobTemplate) String() string { return proto.CompactTextString(m) }
+func (*JobTemplate) ProtoMessage()    {}
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{45}
 }
-func (m *CreateCronJobRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCronJobRequest.Size(m)
+
+func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobTemplate.Unmarshal(m, b)
 }
-func (m *CreateCronJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCronJobRequest.DiscardUnknown(m)
+func (m *JobTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobTemplate.Marshal(b, m, deterministic)
+}
+func (m *JobTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTemplate.Merge(m, src)
+}
+func (m *JobTemplate) XXX_Size() int {
+	return xxx_messageInfo_JobTemplate.Size(m)
+}
+func (m *JobTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTemplate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCronJobRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
 
-func (m *CreateCronJobRequest) GetTemplate() string {
+func (m *JobTemplate) GetName() string {
 	if m != nil {
-		return m.Template
+		return m.Name
 	}
 	return ""
 }
 
-type CreateCronJobResponse struct {
+func (m *JobTemplate) GetParameters() []*TemplateParameter {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+type ListJobTemplatesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCronJobResponse) Reset()         { *m = CreateCronJobResponse{} }
-func (m *CreateCronJobResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateCronJobResponse) ProtoMessage()    {}
-func (*CreateCronJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{8}
+func (m *ListJobTemplatesRequest) Reset()         { *m = ListJobTemplatesRequest{} }
+func (m *ListJobTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobTemplatesRequest) ProtoMessage()    {}
+func (*ListJobTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{46}
 }
 
-func (m *CreateCronJobResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCronJobResponse.Unmarshal(m, b)
+func (m *ListJobTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobTemplatesRequest.Unmarshal(m, b)
 }
-func (m *CreateCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCronJobResponse.Marshal(b, m, deterministic)
+func (m *ListJobTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobTemplatesRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCronJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCronJobResponse.Merge(m, src)
+func (m *ListJobTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobTemplatesRequest.Merge(m, src)
 }
-func (m *CreateCronJobResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateCronJobResponse.Size(m)
+func (m *ListJobTemplatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJobTemplatesRequest.Size(m)
 }
-func (m *CreateCronJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCronJobResponse.DiscardUnknown(m)
+func (m *ListJobTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobTemplatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCronJobResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListJobTemplatesRequest proto.InternalMessageInfo
 
-type DeleteCronJobRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListJobTemplatesResponse struct {
+	Templates            []*JobTemplate `protobuf:"bytes,1,rep,name=Templates,proto3" json:"Templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *DeleteCronJobRequest) Reset()         { *m = DeleteCronJobRequest{} }
-func (m *DeleteCronJobRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteCronJobRequest) ProtoMessage()    {}
-func (*DeleteCronJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{9}
+func (m *ListJobTemplatesResponse) Reset()         { *m = ListJobTemplatesResponse{} }
+func (m *ListJobTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobTemplatesResponse) ProtoMessage()    {}
+func (*ListJobTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{47}
 }
 
-func (m *DeleteCronJobRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteCronJobRequest.Unmarshal(m, b)
+func (m *ListJobTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobTemplatesResponse.Unmarshal(m, b)
 }
-func (m *DeleteCronJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteCronJobRequest.Marshal(b, m, deterministic)
+func (m *ListJobTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobTemplatesResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteCronJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteCronJobRequest.Merge(m, src)
+func (m *ListJobTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobTemplatesResponse.Merge(m, src)
 }
-func (m *DeleteCronJobRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteCronJobRequest.Size(m)
+func (m *ListJobTemplatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListJobTemplatesResponse.Size(m)
 }
-func (m *DeleteCronJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteCronJobRequest.DiscardUnknown(m)
+func (m *ListJobTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobTemplatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteCronJobRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListJobTemplatesResponse proto.InternalMessageInfo
 
-func (m *DeleteCronJobRequest) GetName() string {
+func (m *ListJobTemplatesResponse) GetTemplates() []*JobTemplate {
 	if m != nil {
-		return m.Name
+		return m.Templates
 	}
-	return ""
+	return nil
 }
 
-type DeleteCronJobResponse struct {
+type CreateJobFromTemplateRequest struct {
+	// Name of the ConfigMap holding the template
+	Name   string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Params map[string]string `protobuf:"bytes,2,rep,name=Params,proto3" json:"Params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Wait   bool              `protobuf:"varint,3,opt,name=Wait,proto3" json:"Wait,omitempty"`
+	// WaitTimeoutSeconds bounds the wait, the request deadline applies when zero
+	WaitTimeoutSeconds int64 `protobuf:"varint,4,opt,name=WaitTimeoutSeconds,proto3" json:"WaitTimeoutSeconds,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun bool `protobuf:"varint,5,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	// IdempotencyKey behaves as in CreateJobRequest
+	IdempotencyKey       string   `protobuf:"bytes,6,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteCronJobResponse) Reset()         { *m = DeleteCronJobResponse{} }
-func (m *DeleteCronJobResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteCronJobResponse) ProtoMessage()    {}
-func (*DeleteCronJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{10}
+func (m *CreateJobFromTemplateRequest) Reset()         { *m = CreateJobFromTemplateRequest{} }
+func (m *CreateJobFromTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateJobFromTemplateRequest) ProtoMessage()    {}
+func (*CreateJobFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{48}
 }
 
-func (m *DeleteCronJobResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteCronJobResponse.Unmarshal(m, b)
+func (m *CreateJobFromTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateJobFromTemplateRequest.Unmarshal(m, b)
 }
-func (m *DeleteCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteCronJobResponse.Marshal(b, m, deterministic)
+func (m *CreateJobFromTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateJobFromTemplateRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteCronJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteCronJobResponse.Merge(m, src)
+func (m *CreateJobFromTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateJobFromTemplateRequest.Merge(m, src)
 }
-func (m *DeleteCronJobResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteCronJobResponse.Size(m)
+func (m *CreateJobFromTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateJobFromTemplateRequest.Size(m)
 }
-func (m *DeleteCronJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteCronJobResponse.DiscardUnknown(m)
+func (m *CreateJobFromTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateJobFromTemplateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteCronJobResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateJobFromTemplateRequest proto.InternalMessageInfo
 
-type Job struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateJobFromTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Job) Reset()         { *m = Job{} }
-func (m *Job) String() string { return proto.CompactTextString(m) }
-func (*Job) ProtoMessage()    {}
-func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{11}
+func (m *CreateJobFromTemplateRequest) GetParams() map[string]string {
+	if m != nil {
+		return m.Params
+	}
+	return nil
 }
 
-func (m *Job) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Job.Unmarshal(m, b)
-}
-func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
-}
-func (m *Job) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Job.Merge(m, src)
-}
-func (m *Job) XXX_Size() int {
-	return xxx_messageInfo_Job.Size(m)
+func (m *CreateJobFromTemplateRequest) GetWait() bool {
+	if m != nil {
+		return m.Wait
+	}
+	return false
 }
-func (m *Job) XXX_DiscardUnknown() {
-	xxx_messageInfo_Job.DiscardUnknown(m)
+
+func (m *CreateJobFromTemplateRequest) GetWaitTimeoutSeconds() int64 {
+	if m != nil {
+		return m.WaitTimeoutSeconds
+	}
+	return 0
 }
 
-var xxx_messageInfo_Job proto.InternalMessageInfo
+func (m *CreateJobFromTemplateRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
 
-func (m *Job) GetName() string {
+func (m *CreateJobFromTemplateRequest) GetIdempotencyKey() string {
 	if m != nil {
-		return m.Name
+		return m.IdempotencyKey
 	}
 	return ""
 }
 
-type GetJobsRequest struct {
+type WaitJobRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// TimeoutSeconds bounds the wait, the request deadline applies when zero
+	TimeoutSeconds       int64    `protobuf:"varint,2,opt,name=TimeoutSeconds,proto3" json:"TimeoutSeconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetJobsRequest) Reset()         { *m = GetJobsRequest{} }
-func (m *GetJobsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetJobsRequest) ProtoMessage()    {}
-func (*GetJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{12}
+func (m *WaitJobRequest) Reset()         { *m = WaitJobRequest{} }
+func (m *WaitJobRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitJobRequest) ProtoMessage()    {}
+func (*WaitJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{49}
 }
 
-func (m *GetJobsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetJobsRequest.Unmarshal(m, b)
+func (m *WaitJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitJobRequest.Unmarshal(m, b)
 }
-func (m *GetJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetJobsRequest.Marshal(b, m, deterministic)
+func (m *WaitJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitJobRequest.Marshal(b, m, deterministic)
 }
-func (m *GetJobsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobsRequest.Merge(m, src)
+func (m *WaitJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitJobRequest.Merge(m, src)
 }
-func (m *GetJobsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetJobsRequest.Size(m)
+func (m *WaitJobRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitJobRequest.Size(m)
 }
-func (m *GetJobsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobsRequest.DiscardUnknown(m)
+func (m *WaitJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetJobsRequest proto.InternalMessageInfo
+var xxx_messageInfo_WaitJobRequest proto.InternalMessageInfo
 
-type GetJobsResponse struct {
-	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WaitJobRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *GetJobsResponse) Reset()         { *m = GetJobsResponse{} }
-func (m *GetJobsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetJobsResponse) ProtoMessage()    {}
-func (*GetJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{13}
+func (m *WaitJobRequest) GetTimeoutSeconds() int64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
 }
 
-func (m *GetJobsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetJobsResponse.Unmarshal(m, b)
+type WaitJobResponse struct {
+	Outcome              JobOutcome `protobuf:"varint,1,opt,name=Outcome,proto3,enum=pb.JobOutcome" json:"Outcome,omitempty"`
+	Job                  *Job       `protobuf:"bytes,2,opt,name=Job,proto3" json:"Job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (m *GetJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetJobsResponse.Marshal(b, m, deterministic)
+
+func (m *WaitJobResponse) Reset()         { *m = WaitJobResponse{} }
+func (m *WaitJobResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitJobResponse) ProtoMessage()    {}
+func (*WaitJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{50}
 }
-func (m *GetJobsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobsResponse.Merge(m, src)
+
+func (m *WaitJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitJobResponse.Unmarshal(m, b)
 }
-func (m *GetJobsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetJobsResponse.Size(m)
+func (m *WaitJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitJobResponse.Marshal(b, m, deterministic)
 }
-func (m *GetJobsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobsResponse.DiscardUnknown(m)
+func (m *WaitJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitJobResponse.Merge(m, src)
+}
+func (m *WaitJobResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitJobResponse.Size(m)
+}
+func (m *WaitJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitJobResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetJobsResponse proto.InternalMessageInfo
+var xxx_messageInfo_WaitJobResponse proto.InternalMessageInfo
 
-func (m *GetJobsResponse) GetJobs() []*Job {
+func (m *WaitJobResponse) GetOutcome() JobOutcome {
 	if m != nil {
-		return m.Jobs
+		return m.Outcome
+	}
+	return JobOutcome_UNFINISHED
+}
+
+func (m *WaitJobResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
 	}
 	return nil
 }
 
-type GetJobRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+type WatchJobRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetJobRequest) Reset()         { *m = GetJobRequest{} }
-func (m *GetJobRequest) String() string { return proto.CompactTextString(m) }
-func (*GetJobRequest) ProtoMessage()    {}
-func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{14}
+func (m *WatchJobRequest) Reset()         { *m = WatchJobRequest{} }
+func (m *WatchJobRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobRequest) ProtoMessage()    {}
+func (*WatchJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{51}
 }
 
-func (m *GetJobRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetJobRequest.Unmarshal(m, b)
+func (m *WatchJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobRequest.Unmarshal(m, b)
 }
-func (m *GetJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetJobRequest.Marshal(b, m, deterministic)
+func (m *WatchJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobRequest.Marshal(b, m, deterministic)
 }
-func (m *GetJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobRequest.Merge(m, src)
+func (m *WatchJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobRequest.Merge(m, src)
 }
-func (m *GetJobRequest) XXX_Size() int {
-	return xxx_messageInfo_GetJobRequest.Size(m)
+func (m *WatchJobRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchJobRequest.Size(m)
 }
-func (m *GetJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobRequest.DiscardUnknown(m)
+func (m *WatchJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetJobRequest proto.InternalMessageInfo
+var xxx_messageInfo_WatchJobRequest proto.InternalMessageInfo
 
-func (m *GetJobRequest) GetId() string {
+func (m *WatchJobRequest) GetName() string {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return ""
 }
 
-type GetJobResponse struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type WatchJobResponse struct {
+	Type WatchEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=pb.WatchEventType" json:"Type,omitempty"`
+	Job  *Job           `protobuf:"bytes,2,opt,name=Job,proto3" json:"Job,omitempty"`
+	// Outcome is set on the final event of the stream once the Job has finished
+	Outcome              JobOutcome `protobuf:"varint,3,opt,name=Outcome,proto3,enum=pb.JobOutcome" json:"Outcome,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetJobResponse) Reset()         { *m = GetJobResponse{} }
-func (m *GetJobResponse) String() string { return proto.CompactTextString(m) }
-func (*GetJobResponse) ProtoMessage()    {}
-func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{15}
+func (m *WatchJobResponse) Reset()         { *m = WatchJobResponse{} }
+func (m *WatchJobResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchJobResponse) ProtoMessage()    {}
+func (*WatchJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{52}
 }
 
-func (m *GetJobResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetJobResponse.Unmarshal(m, b)
+func (m *WatchJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobResponse.Unmarshal(m, b)
 }
-func (m *GetJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetJobResponse.Marshal(b, m, deterministic)
+func (m *WatchJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobResponse.Marshal(b, m, deterministic)
 }
-func (m *GetJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobResponse.Merge(m, src)
+func (m *WatchJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobResponse.Merge(m, src)
 }
-func (m *GetJobResponse) XXX_Size() int {
-	return xxx_messageInfo_GetJobResponse.Size(m)
+func (m *WatchJobResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchJobResponse.Size(m)
 }
-func (m *GetJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobResponse.DiscardUnknown(m)
+func (m *WatchJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetJobResponse proto.InternalMessageInfo
+var xxx_messageInfo_WatchJobResponse proto.InternalMessageInfo
 
-func (m *GetJobResponse) GetJob() *Job {
+func (m *WatchJobResponse) GetType() WatchEventType {
+	if m != nil {
+		return m.Type
+	}
+	return WatchEventType_INITIAL
+}
+
+func (m *WatchJobResponse) GetJob() *Job {
 	if m != nil {
 		return m.Job
 	}
 	return nil
 }
 
-type CreateJobRequest struct {
-	Template             string   `protobuf:"bytes,1,opt,name=Template,proto3" json:"Template,omitempty"`
+func (m *WatchJobResponse) GetOutcome() JobOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return JobOutcome_UNFINISHED
+}
+
+type StreamJobLogsRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Container to read, every container of the Job pods when empty
+	Container string `protobuf:"bytes,2,opt,name=Container,proto3" json:"Container,omitempty"`
+	// Follow streams containers as they start, including those of pods created later, until the Job has finished
+	Follow               bool     `protobuf:"varint,3,opt,name=Follow,proto3" json:"Follow,omitempty"`
+	SinceSeconds         int64    `protobuf:"varint,4,opt,name=SinceSeconds,proto3" json:"SinceSeconds,omitempty"`
+	TailLines            int64    `protobuf:"varint,5,opt,name=TailLines,proto3" json:"TailLines,omitempty"`
+	Previous             bool     `protobuf:"varint,6,opt,name=Previous,proto3" json:"Previous,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
-func (m *CreateJobRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateJobRequest) ProtoMessage()    {}
-func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{16}
+func (m *StreamJobLogsRequest) Reset()         { *m = StreamJobLogsRequest{} }
+func (m *StreamJobLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamJobLogsRequest) ProtoMessage()    {}
+func (*StreamJobLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{53}
 }
 
-func (m *CreateJobRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateJobRequest.Unmarshal(m, b)
+func (m *StreamJobLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamJobLogsRequest.Unmarshal(m, b)
 }
-func (m *CreateJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateJobRequest.Marshal(b, m, deterministic)
+func (m *StreamJobLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamJobLogsRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateJobRequest.Merge(m, src)
+func (m *StreamJobLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamJobLogsRequest.Merge(m, src)
 }
-func (m *CreateJobRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateJobRequest.Size(m)
+func (m *StreamJobLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamJobLogsRequest.Size(m)
 }
-func (m *CreateJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateJobRequest.DiscardUnknown(m)
+func (m *StreamJobLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamJobLogsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateJobRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamJobLogsRequest proto.InternalMessageInfo
 
-func (m *CreateJobRequest) GetTemplate() string {
+func (m *StreamJobLogsRequest) GetName() string {
 	if m != nil {
-		return m.Template
+		return m.Name
 	}
 	return ""
 }
 
-type CreateJobResponse struct {
+func (m *StreamJobLogsRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *StreamJobLogsRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
+func (m *StreamJobLogsRequest) GetSinceSeconds() int64 {
+	if m != nil {
+		return m.SinceSeconds
+	}
+	return 0
+}
+
+func (m *StreamJobLogsRequest) GetTailLines() int64 {
+	if m != nil {
+		return m.TailLines
+	}
+	return 0
+}
+
+func (m *StreamJobLogsRequest) GetPrevious() bool {
+	if m != nil {
+		return m.Previous
+	}
+	return false
+}
+
+type StreamJobLogsResponse struct {
+	Pod       string `protobuf:"bytes,1,opt,name=Pod,proto3" json:"Pod,omitempty"`
+	Container string `protobuf:"bytes,2,opt,name=Container,proto3" json:"Container,omitempty"`
+	// Line is prefixed with the pod name, and the container name when every container is read
+	Line                 string   `protobuf:"bytes,3,opt,name=Line,proto3" json:"Line,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateJobResponse) Reset()         { *m = CreateJobResponse{} }
-func (m *CreateJobResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateJobResponse) ProtoMessage()    {}
-func (*CreateJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{17}
+func (m *StreamJobLogsResponse) Reset()         { *m = StreamJobLogsResponse{} }
+func (m *StreamJobLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamJobLogsResponse) ProtoMessage()    {}
+func (*StreamJobLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903244fefde60d5, []int{54}
 }
 
-func (m *CreateJobResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateJobResponse.Unmarshal(m, b)
+func (m *StreamJobLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamJobLogsResponse.Unmarshal(m, b)
 }
-func (m *CreateJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateJobResponse.Marshal(b, m, deterministic)
+func (m *StreamJobLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamJobLogsResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateJobResponse.Merge(m, src)
+func (m *StreamJobLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamJobLogsResponse.Merge(m, src)
 }
-func (m *CreateJobResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateJobResponse.Size(m)
+func (m *StreamJobLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamJobLogsResponse.Size(m)
 }
-func (m *CreateJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateJobResponse.DiscardUnknown(m)
+func (m *StreamJobLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamJobLogsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateJobResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamJobLogsResponse proto.InternalMessageInfo
+
+func (m *StreamJobLogsResponse) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *StreamJobLogsResponse) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *StreamJobLogsResponse) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
 
 type DeleteJobRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// DryRun validates the request against the API server without persisting anything
+	DryRun               bool     `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -695,7 +3202,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{18}
+	return fileDescriptor_7903244fefde60d5, []int{55}
 }
 
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
@@ -723,6 +3230,13 @@ func (m *DeleteJobRequest) GetName() string {
 	return ""
 }
 
+func (m *DeleteJobRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type DeleteJobResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -733,7 +3247,7 @@ func (m *DeleteJobResponse) Reset()         { *m = DeleteJobResponse{} }
 func (m *DeleteJobResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobResponse) ProtoMessage()    {}
 func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903244fefde60d5, []int{19}
+	return fileDescriptor_7903244fefde60d5, []int{56}
 }
 
 func (m *DeleteJobResponse) XXX_Unmarshal(b []byte) error {
@@ -755,17 +3269,59 @@ func (m *DeleteJobResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteJobResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("pb.WatchEventType", WatchEventType_name, WatchEventType_value)
+	proto.RegisterEnum("pb.TemplateFormat", TemplateFormat_name, TemplateFormat_value)
+	proto.RegisterEnum("pb.CronJobWait", CronJobWait_name, CronJobWait_value)
+	proto.RegisterEnum("pb.UpdateStrategy", UpdateStrategy_name, UpdateStrategy_value)
+	proto.RegisterEnum("pb.JobOutcome", JobOutcome_name, JobOutcome_value)
+	proto.RegisterType((*ObjectIdentity)(nil), "pb.ObjectIdentity")
 	proto.RegisterType((*CronJob)(nil), "pb.CronJob")
+	proto.RegisterMapType((map[string]string)(nil), "pb.CronJob.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.CronJob.LabelsEntry")
+	proto.RegisterType((*ConfigMap)(nil), "pb.ConfigMap")
+	proto.RegisterMapType((map[string]string)(nil), "pb.ConfigMap.AnnotationsEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "pb.ConfigMap.BinaryDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.ConfigMap.DataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.ConfigMap.LabelsEntry")
 	proto.RegisterType((*GetConfigMapRequest)(nil), "pb.GetConfigMapRequest")
 	proto.RegisterType((*GetConfigMapResponse)(nil), "pb.GetConfigMapResponse")
+	proto.RegisterType((*CreateConfigMapRequest)(nil), "pb.CreateConfigMapRequest")
+	proto.RegisterType((*CreateConfigMapResponse)(nil), "pb.CreateConfigMapResponse")
+	proto.RegisterType((*UpdateConfigMapRequest)(nil), "pb.UpdateConfigMapRequest")
+	proto.RegisterType((*UpdateConfigMapResponse)(nil), "pb.UpdateConfigMapResponse")
+	proto.RegisterType((*PatchConfigMapKeysRequest)(nil), "pb.PatchConfigMapKeysRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pb.PatchConfigMapKeysRequest.SetEntry")
+	proto.RegisterType((*PatchConfigMapKeysResponse)(nil), "pb.PatchConfigMapKeysResponse")
+	proto.RegisterType((*DeleteConfigMapRequest)(nil), "pb.DeleteConfigMapRequest")
+	proto.RegisterType((*DeleteConfigMapResponse)(nil), "pb.DeleteConfigMapResponse")
+	proto.RegisterType((*WatchConfigMapRequest)(nil), "pb.WatchConfigMapRequest")
+	proto.RegisterType((*WatchConfigMapResponse)(nil), "pb.WatchConfigMapResponse")
+	proto.RegisterType((*Secret)(nil), "pb.Secret")
+	proto.RegisterMapType((map[string]string)(nil), "pb.Secret.AnnotationsEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "pb.Secret.DataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.Secret.LabelsEntry")
+	proto.RegisterType((*GetSecretRequest)(nil), "pb.GetSecretRequest")
+	proto.RegisterType((*GetSecretResponse)(nil), "pb.GetSecretResponse")
+	proto.RegisterType((*ListSecretsRequest)(nil), "pb.ListSecretsRequest")
+	proto.RegisterType((*ListSecretsResponse)(nil), "pb.ListSecretsResponse")
+	proto.RegisterType((*WatchSecretRequest)(nil), "pb.WatchSecretRequest")
+	proto.RegisterType((*WatchSecretResponse)(nil), "pb.WatchSecretResponse")
 	proto.RegisterType((*GetCronJobsRequest)(nil), "pb.GetCronJobsRequest")
 	proto.RegisterType((*GetCronJobsResponse)(nil), "pb.GetCronJobsResponse")
 	proto.RegisterType((*GetCronJobRequest)(nil), "pb.GetCronJobRequest")
 	proto.RegisterType((*GetCronJobResponse)(nil), "pb.GetCronJobResponse")
 	proto.RegisterType((*CreateCronJobRequest)(nil), "pb.CreateCronJobRequest")
 	proto.RegisterType((*CreateCronJobResponse)(nil), "pb.CreateCronJobResponse")
+	proto.RegisterType((*TriggerCronJobRequest)(nil), "pb.TriggerCronJobRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pb.TriggerCronJobRequest.EnvEntry")
+	proto.RegisterType((*TriggerCronJobResponse)(nil), "pb.TriggerCronJobResponse")
+	proto.RegisterType((*UpdateCronJobRequest)(nil), "pb.UpdateCronJobRequest")
+	proto.RegisterType((*UpdateCronJobResponse)(nil), "pb.UpdateCronJobResponse")
+	proto.RegisterType((*SetCronJobSuspendedRequest)(nil), "pb.SetCronJobSuspendedRequest")
+	proto.RegisterType((*SetCronJobSuspendedResponse)(nil), "pb.SetCronJobSuspendedResponse")
 	proto.RegisterType((*DeleteCronJobRequest)(nil), "pb.DeleteCronJobRequest")
 	proto.RegisterType((*DeleteCronJobResponse)(nil), "pb.DeleteCronJobResponse")
+	proto.RegisterType((*JobCondition)(nil), "pb.JobCondition")
 	proto.RegisterType((*Job)(nil), "pb.Job")
 	proto.RegisterType((*GetJobsRequest)(nil), "pb.GetJobsRequest")
 	proto.RegisterType((*GetJobsResponse)(nil), "pb.GetJobsResponse")
@@ -773,6 +3329,18 @@ func init() {
 	proto.RegisterType((*GetJobResponse)(nil), "pb.GetJobResponse")
 	proto.RegisterType((*CreateJobRequest)(nil), "pb.CreateJobRequest")
 	proto.RegisterType((*CreateJobResponse)(nil), "pb.CreateJobResponse")
+	proto.RegisterType((*TemplateParameter)(nil), "pb.TemplateParameter")
+	proto.RegisterType((*JobTemplate)(nil), "pb.JobTemplate")
+	proto.RegisterType((*ListJobTemplatesRequest)(nil), "pb.ListJobTemplatesRequest")
+	proto.RegisterType((*ListJobTemplatesResponse)(nil), "pb.ListJobTemplatesResponse")
+	proto.RegisterType((*CreateJobFromTemplateRequest)(nil), "pb.CreateJobFromTemplateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pb.CreateJobFromTemplateRequest.ParamsEntry")
+	proto.RegisterType((*WaitJobRequest)(nil), "pb.WaitJobRequest")
+	proto.RegisterType((*WaitJobResponse)(nil), "pb.WaitJobResponse")
+	proto.RegisterType((*WatchJobRequest)(nil), "pb.WatchJobRequest")
+	proto.RegisterType((*WatchJobResponse)(nil), "pb.WatchJobResponse")
+	proto.RegisterType((*StreamJobLogsRequest)(nil), "pb.StreamJobLogsRequest")
+	proto.RegisterType((*StreamJobLogsResponse)(nil), "pb.StreamJobLogsResponse")
 	proto.RegisterType((*DeleteJobRequest)(nil), "pb.DeleteJobRequest")
 	proto.RegisterType((*DeleteJobResponse)(nil), "pb.DeleteJobResponse")
 }
@@ -780,38 +3348,172 @@ func init() {
 func init() { proto.RegisterFile("k8s_service.proto", fileDescriptor_7903244fefde60d5) }
 
 var fileDescriptor_7903244fefde60d5 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6b, 0xd4, 0x40,
-	0x14, 0xdd, 0x2f, 0x76, 0xdb, 0xb3, 0xb6, 0x6e, 0xee, 0x66, 0x3f, 0xba, 0x22, 0x96, 0x11, 0x6d,
-	0x51, 0x08, 0xb8, 0xfa, 0x50, 0x14, 0x45, 0x58, 0xb1, 0xd4, 0xa2, 0x0f, 0xab, 0xef, 0x92, 0xd8,
-	0x51, 0x8a, 0x6d, 0x12, 0x93, 0x28, 0xf8, 0xfb, 0xfc, 0x63, 0x92, 0xdc, 0x99, 0xc9, 0xe4, 0x43,
-	0xec, 0x5b, 0x72, 0xe6, 0x9c, 0x73, 0xef, 0x9c, 0x9c, 0x5d, 0x38, 0xdf, 0x4f, 0xd2, 0xcf, 0xa9,
-	0x4c, 0x7e, 0x5d, 0x7e, 0x91, 0x5e, 0x9c, 0x44, 0x59, 0x44, 0xbd, 0x38, 0x10, 0x77, 0x31, 0xda,
-	0x24, 0x51, 0xf8, 0x2e, 0x0a, 0x88, 0x30, 0xf8, 0xe0, 0x5f, 0xcb, 0x65, 0xf7, 0xb0, 0x7b, 0xbc,
-	0xbb, 0x2d, 0x9e, 0xc5, 0x11, 0xa6, 0xa7, 0x32, 0xdb, 0x44, 0xe1, 0xd7, 0xcb, 0x6f, 0xef, 0xfd,
-	0x78, 0x2b, 0x7f, 0xfc, 0x94, 0x69, 0x46, 0x13, 0xf4, 0xcf, 0xe5, 0x6f, 0xc5, 0xcc, 0x1f, 0x85,
-	0x07, 0xb7, 0x4a, 0x4c, 0xe3, 0x28, 0x4c, 0x25, 0xcd, 0x31, 0x64, 0x50, 0x91, 0xd5, 0x9b, 0x70,
-	0x41, 0x39, 0x9f, 0x47, 0xa7, 0xca, 0x57, 0xbc, 0xe2, 0x71, 0x06, 0x55, 0x26, 0x47, 0xd8, 0xd1,
-	0xd8, 0xb2, 0x7b, 0xd8, 0x3f, 0x1e, 0xaf, 0xc7, 0x5e, 0x1c, 0x78, 0x0a, 0xdb, 0x9a, 0x43, 0x71,
-	0x1f, 0x4e, 0xa9, 0xd7, 0xcb, 0xee, 0xa3, 0x77, 0x76, 0xa1, 0xc6, 0xf7, 0xce, 0x2e, 0xc4, 0x0b,
-	0x7b, 0xb4, 0x99, 0xf1, 0xc0, 0x04, 0x51, 0x50, 0x6b, 0x23, 0xf4, 0x99, 0x58, 0xc3, 0xdd, 0x24,
-	0xd2, 0xcf, 0x64, 0x6d, 0xc8, 0x0a, 0x3b, 0x9f, 0xe4, 0x75, 0x7c, 0xe5, 0x67, 0x3a, 0x40, 0xf3,
-	0x2e, 0x16, 0x98, 0xd5, 0x34, 0x3c, 0x53, 0x3c, 0x82, 0xfb, 0x46, 0x5e, 0xc9, 0x86, 0x59, 0xdb,
-	0x97, 0x58, 0x60, 0x56, 0xe3, 0x2a, 0x93, 0x03, 0xf4, 0xd5, 0xd7, 0x0b, 0x2d, 0x4d, 0xfe, 0x2c,
-	0x26, 0xd8, 0x3f, 0x95, 0x99, 0x1d, 0xb0, 0x87, 0xdb, 0x06, 0x51, 0x17, 0xbf, 0x83, 0x81, 0x15,
-	0xec, 0x28, 0xbf, 0x75, 0x6e, 0x5f, 0x80, 0xe2, 0x1e, 0xf6, 0x98, 0xff, 0xaf, 0x30, 0x1f, 0xeb,
-	0x11, 0xc6, 0x8f, 0xf7, 0x51, 0x21, 0x1a, 0xbb, 0x1c, 0x13, 0x1e, 0x26, 0x1c, 0xc4, 0x0d, 0x83,
-	0x9b, 0xc2, 0xb1, 0xf8, 0xea, 0xbe, 0x0f, 0x31, 0xe1, 0x20, 0xfe, 0x13, 0xd8, 0x14, 0x8e, 0xc5,
-	0x63, 0xf1, 0xfa, 0xcf, 0x00, 0x38, 0x3f, 0x49, 0x3f, 0xf2, 0xef, 0x80, 0x36, 0xb8, 0x65, 0xb7,
-	0x96, 0x16, 0xf9, 0xba, 0x2d, 0x85, 0x5f, 0x2d, 0x9b, 0x07, 0x6a, 0x9d, 0x0e, 0xbd, 0xc6, 0xd8,
-	0x2a, 0x2d, 0xcd, 0x35, 0xb5, 0xda, 0xed, 0xd5, 0xa2, 0x81, 0x1b, 0x87, 0x97, 0x40, 0x79, 0x40,
-	0xb3, 0x2a, 0x51, 0xeb, 0xe7, 0x75, 0xd8, 0xc8, 0xdf, 0x62, 0xaf, 0xd2, 0x2f, 0x5a, 0x72, 0x75,
-	0x9b, 0x35, 0x5d, 0x1d, 0xb4, 0x9c, 0xd8, 0x3e, 0x95, 0x8a, 0xb1, 0x4f, 0x5b, 0x43, 0xd9, 0xa7,
-	0xbd, 0x8f, 0x1d, 0x7a, 0x86, 0x91, 0x2a, 0x19, 0x91, 0x5a, 0xda, 0x0e, 0x62, 0x5a, 0xc1, 0x8c,
-	0xea, 0x09, 0x86, 0x0c, 0x92, 0x53, 0x12, 0xb4, 0x86, 0x6c, 0xc8, 0x48, 0x9e, 0x63, 0xd7, 0xf4,
-	0x83, 0xdc, 0xf2, 0x6a, 0x96, 0x70, 0x56, 0x43, 0x6d, 0xad, 0xa9, 0x07, 0x6b, 0xeb, 0xad, 0x62,
-	0x6d, 0xa3, 0x43, 0xa2, 0x13, 0x0c, 0x8b, 0xff, 0xcf, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xf4, 0x0d, 0xdb, 0xbe, 0x54, 0x05, 0x00, 0x00,
+	// 2626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0x4d, 0x73, 0xdb, 0xc6,
+	0xd5, 0x20, 0x29, 0x7e, 0x3c, 0xda, 0x14, 0xb5, 0x12, 0x25, 0x0a, 0x72, 0x1c, 0x0d, 0xd2, 0xb8,
+	0x1a, 0x25, 0x65, 0x12, 0x37, 0x4d, 0x1c, 0x67, 0xec, 0x09, 0x4d, 0x52, 0x36, 0x65, 0x5a, 0xd2,
+	0x80, 0x54, 0x92, 0x36, 0xd3, 0xf1, 0x80, 0xe4, 0x4a, 0x41, 0x42, 0x02, 0x2c, 0x00, 0xaa, 0xd1,
+	0x29, 0xff, 0xa0, 0xd7, 0x9e, 0x7a, 0xee, 0x4c, 0x2f, 0x6d, 0x4f, 0xed, 0xa9, 0xff, 0xa2, 0x87,
+	0x5e, 0x3a, 0x9d, 0x69, 0xff, 0x44, 0xa7, 0x97, 0xce, 0x7e, 0x62, 0x01, 0x82, 0xa4, 0xe9, 0xa6,
+	0xd3, 0xde, 0xb0, 0xef, 0xed, 0x7b, 0xfb, 0x3e, 0xf6, 0x7d, 0xec, 0x03, 0x6c, 0x7c, 0x7d, 0xdf,
+	0x7f, 0xe1, 0x63, 0xef, 0xca, 0x1e, 0xe0, 0xda, 0xc4, 0x73, 0x03, 0x17, 0xa5, 0x26, 0x7d, 0xfd,
+	0xf5, 0x4b, 0xd7, 0xbd, 0x1c, 0xe1, 0x77, 0x28, 0xa4, 0x3f, 0xbd, 0x78, 0x27, 0xb0, 0xc7, 0xd8,
+	0x0f, 0xac, 0xf1, 0x84, 0x6d, 0x32, 0x7e, 0xa3, 0x41, 0xe9, 0xb4, 0xff, 0x15, 0x1e, 0x04, 0xed,
+	0x21, 0x76, 0x02, 0x3b, 0xb8, 0x46, 0x08, 0x32, 0x27, 0xd6, 0x18, 0x57, 0xb5, 0x7d, 0xed, 0xa0,
+	0x60, 0xd2, 0x6f, 0x54, 0x86, 0xf4, 0x79, 0xbb, 0x59, 0x4d, 0x51, 0x10, 0xf9, 0x44, 0x07, 0xb0,
+	0x6e, 0x62, 0xdf, 0x9d, 0x7a, 0x03, 0xfc, 0x29, 0xf6, 0x7c, 0xdb, 0x75, 0xaa, 0x69, 0x8a, 0x8d,
+	0x83, 0xd1, 0x53, 0xd8, 0x68, 0x78, 0xd8, 0x0a, 0x6c, 0xd7, 0xe9, 0x89, 0xd3, 0xab, 0x99, 0x7d,
+	0xed, 0xa0, 0x78, 0x4f, 0xaf, 0x31, 0xf9, 0x6a, 0x42, 0xbe, 0x9a, 0xdc, 0x61, 0xce, 0x12, 0x19,
+	0x7f, 0xce, 0x40, 0xae, 0xe1, 0xb9, 0xce, 0xb1, 0xdb, 0x4f, 0x94, 0x52, 0x87, 0x7c, 0x77, 0xf0,
+	0x25, 0x1e, 0x4e, 0x47, 0x98, 0x8b, 0x2a, 0xd7, 0x04, 0x47, 0x18, 0xfd, 0xc4, 0x75, 0x30, 0x17,
+	0x54, 0xae, 0x51, 0x15, 0x72, 0xdd, 0xa9, 0x3f, 0xc1, 0xce, 0x90, 0xca, 0x95, 0x37, 0xc5, 0x12,
+	0xbd, 0x0d, 0x1b, 0x0d, 0xd7, 0x19, 0x4c, 0x3d, 0x0f, 0x3b, 0x83, 0xeb, 0x33, 0x77, 0x64, 0x0f,
+	0xae, 0xab, 0x6b, 0x94, 0x7c, 0x16, 0x81, 0x8e, 0xa0, 0xdc, 0xb1, 0xfc, 0x40, 0x9c, 0x49, 0xf8,
+	0x57, 0xb3, 0x4b, 0x15, 0x9d, 0xa1, 0x41, 0xc7, 0x80, 0x28, 0x6c, 0x3a, 0x18, 0x60, 0xdf, 0xbf,
+	0x98, 0x8e, 0x28, 0xa7, 0xdc, 0x52, 0x4e, 0x09, 0x54, 0xe8, 0x0e, 0x40, 0x7d, 0x10, 0xd8, 0x57,
+	0xf8, 0xd8, 0xed, 0xfb, 0xd5, 0xfc, 0x7e, 0xfa, 0xa0, 0x60, 0x2a, 0x10, 0xf4, 0x0e, 0x64, 0x3b,
+	0x56, 0x1f, 0x8f, 0xfc, 0x6a, 0x61, 0x3f, 0x7d, 0x50, 0xbc, 0xb7, 0x53, 0x9b, 0xf4, 0x6b, 0xdc,
+	0xc8, 0x35, 0x86, 0x69, 0x39, 0x81, 0x77, 0x6d, 0xf2, 0x6d, 0xe8, 0x11, 0x14, 0xeb, 0x8e, 0xe3,
+	0x06, 0xd4, 0x37, 0x7e, 0x15, 0x28, 0xd5, 0x6d, 0x95, 0x4a, 0x41, 0x33, 0x52, 0x95, 0x00, 0x6d,
+	0x43, 0xb6, 0x3d, 0xb6, 0x2e, 0xb1, 0x5f, 0x2d, 0x52, 0x61, 0xf8, 0x4a, 0xff, 0x08, 0x8a, 0xca,
+	0x71, 0xe4, 0xc6, 0x7d, 0x8d, 0xaf, 0xb9, 0x7b, 0xc9, 0x27, 0xda, 0x82, 0xb5, 0x2b, 0x6b, 0x34,
+	0x15, 0xae, 0x65, 0x8b, 0x07, 0xa9, 0xfb, 0x9a, 0xfe, 0x08, 0xca, 0xf1, 0x33, 0x57, 0xa1, 0x37,
+	0x7e, 0x95, 0x81, 0x42, 0xc3, 0x75, 0x2e, 0xec, 0xcb, 0xe7, 0xd6, 0x24, 0xf1, 0x66, 0xbd, 0x05,
+	0x99, 0xa6, 0x15, 0x58, 0xd5, 0x94, 0x62, 0x23, 0x41, 0x50, 0x23, 0x18, 0xa6, 0x28, 0xdd, 0x84,
+	0x1e, 0x02, 0x3c, 0xb6, 0x1d, 0xcb, 0xbb, 0xa6, 0x24, 0x69, 0x4a, 0xf2, 0x5a, 0x94, 0x24, 0xc4,
+	0x33, 0x42, 0x85, 0x20, 0x29, 0xb2, 0x32, 0xc9, 0x91, 0xf5, 0x9e, 0xf4, 0xdd, 0x1a, 0x3d, 0x64,
+	0x37, 0x7a, 0x48, 0x92, 0xf7, 0x3e, 0x89, 0x7a, 0x2f, 0x4b, 0xe9, 0xee, 0x44, 0xe9, 0x16, 0xfa,
+	0x4f, 0xff, 0x10, 0x0a, 0x52, 0xee, 0x95, 0xbc, 0xf4, 0x10, 0xd6, 0x63, 0x6a, 0x2f, 0x23, 0xbf,
+	0xa9, 0x92, 0xff, 0x0f, 0xef, 0xc7, 0xc7, 0xb0, 0xf9, 0x04, 0x07, 0xd2, 0x40, 0x26, 0xfe, 0xd9,
+	0x14, 0xfb, 0x01, 0x61, 0xf1, 0x2c, 0x64, 0xf1, 0x0c, 0x87, 0xa9, 0x33, 0x15, 0x5e, 0x1d, 0xe3,
+	0x0b, 0xd8, 0x8a, 0x12, 0xfb, 0x13, 0xd7, 0xf1, 0x31, 0x89, 0x03, 0x06, 0xe4, 0x0c, 0xf8, 0x0a,
+	0xbd, 0xa5, 0xdc, 0x45, 0xca, 0xa8, 0x78, 0xef, 0x56, 0xc4, 0x3f, 0x66, 0x88, 0x37, 0x7e, 0x0a,
+	0xdb, 0x34, 0x4d, 0xe2, 0x19, 0xe1, 0x22, 0x6c, 0xb4, 0xc5, 0x6c, 0x88, 0x2c, 0x4d, 0xef, 0xda,
+	0x9c, 0x3a, 0xf4, 0xc0, 0xbc, 0xc9, 0x57, 0xc6, 0x11, 0xec, 0xcc, 0xb0, 0xe7, 0xe2, 0xaf, 0xc2,
+	0x9f, 0x88, 0x79, 0x3e, 0x19, 0xfe, 0x37, 0xc5, 0x9c, 0x61, 0xff, 0x2a, 0x62, 0xfe, 0x4b, 0x83,
+	0xdd, 0x33, 0x2b, 0x18, 0x7c, 0x29, 0x41, 0xcf, 0xf0, 0xb5, 0x2f, 0x44, 0x4d, 0xca, 0x0b, 0xf7,
+	0x21, 0xdd, 0xc5, 0x01, 0x4f, 0x0b, 0x77, 0x09, 0xe3, 0xb9, 0xf4, 0xb5, 0x2e, 0x0e, 0x58, 0x38,
+	0x11, 0x12, 0xa2, 0x8b, 0x89, 0xc7, 0xee, 0x15, 0xa6, 0x09, 0xa2, 0x60, 0xf2, 0xd5, 0x0a, 0xd1,
+	0x1f, 0x5a, 0x63, 0x4d, 0xb5, 0x86, 0xfe, 0x01, 0xe4, 0xc5, 0x51, 0x2b, 0xdd, 0xf2, 0x36, 0xe8,
+	0x49, 0xc2, 0xbf, 0x8a, 0x21, 0x1d, 0xd8, 0x6e, 0xe2, 0x11, 0x4e, 0xf0, 0x77, 0x92, 0x11, 0x13,
+	0x54, 0x4e, 0x2d, 0x53, 0x39, 0x1d, 0xb9, 0x00, 0xbb, 0xb0, 0x33, 0x73, 0x1e, 0x93, 0xdb, 0xe8,
+	0x42, 0xe5, 0xb3, 0x88, 0x56, 0x8a, 0x24, 0x44, 0xc1, 0xaa, 0x46, 0xcd, 0x4f, 0xbf, 0x89, 0x71,
+	0x88, 0x44, 0x3e, 0x75, 0x68, 0xc1, 0x64, 0x0b, 0x11, 0xe7, 0x69, 0x19, 0xe7, 0xc6, 0x2f, 0x35,
+	0xd8, 0x8e, 0x73, 0xe5, 0x76, 0xba, 0x0b, 0x99, 0xde, 0xf5, 0x84, 0x29, 0x58, 0xba, 0x87, 0x88,
+	0x89, 0xe8, 0xce, 0xd6, 0x15, 0x76, 0x02, 0x82, 0x31, 0x29, 0x5e, 0x30, 0x4d, 0x85, 0xc9, 0x23,
+	0x4c, 0x08, 0xe9, 0xf9, 0x09, 0x21, 0xb3, 0xc4, 0xf2, 0xbf, 0x4e, 0x43, 0xb6, 0x8b, 0x07, 0x1e,
+	0x4e, 0x36, 0x35, 0xe2, 0xd2, 0xf1, 0x04, 0x45, 0x25, 0x39, 0xe0, 0xb5, 0x8d, 0x15, 0xaa, 0x2d,
+	0xc2, 0x9a, 0x71, 0x98, 0x29, 0x6c, 0x2f, 0x7f, 0x37, 0x6b, 0xb1, 0xca, 0xb4, 0xad, 0x70, 0x4d,
+	0x2a, 0x4b, 0x0f, 0x93, 0xca, 0xd2, 0x9e, 0x42, 0xf4, 0xdd, 0xd5, 0xa4, 0xff, 0x97, 0xa2, 0x72,
+	0x17, 0xca, 0x4f, 0x70, 0xc0, 0xd4, 0x5b, 0x10, 0x1d, 0xc6, 0x87, 0xb0, 0xa1, 0xec, 0xe3, 0xb7,
+	0xcc, 0x10, 0x5e, 0xe6, 0xa1, 0x08, 0xa1, 0xa9, 0x4c, 0x8e, 0x31, 0xb6, 0x00, 0x75, 0x6c, 0x9f,
+	0x53, 0x8a, 0x2c, 0x44, 0x6a, 0x59, 0x04, 0xca, 0x19, 0x7e, 0x0f, 0x72, 0x1c, 0x44, 0x03, 0x22,
+	0xca, 0x51, 0xa0, 0x8c, 0x43, 0x40, 0xf4, 0x32, 0x47, 0xa5, 0x96, 0x51, 0xa3, 0x29, 0x51, 0x63,
+	0x58, 0xb0, 0x19, 0xd9, 0xbb, 0x62, 0x7c, 0x84, 0x1a, 0xa6, 0x16, 0x69, 0x48, 0x4a, 0x2b, 0x6b,
+	0x3b, 0xa5, 0x86, 0x8f, 0x60, 0x33, 0x02, 0xe5, 0x07, 0x7f, 0x1f, 0xf2, 0x02, 0xc6, 0x55, 0x2c,
+	0x2a, 0x4d, 0xab, 0x29, 0x91, 0xc6, 0x1b, 0xd4, 0xe0, 0x02, 0xce, 0x75, 0x2c, 0x41, 0xaa, 0x3d,
+	0xe4, 0x7e, 0x49, 0xb5, 0x87, 0xc6, 0xc7, 0xea, 0xd1, 0xf2, 0x8c, 0x37, 0xe5, 0xfb, 0x84, 0xfb,
+	0x25, 0x72, 0x84, 0xc0, 0x19, 0xff, 0xd4, 0x60, 0x8b, 0xd7, 0xd5, 0xe8, 0x29, 0xe4, 0x91, 0x82,
+	0xc7, 0x93, 0x91, 0x15, 0x88, 0x3b, 0x20, 0xd7, 0xe8, 0x0d, 0xc8, 0x7c, 0x66, 0xd9, 0xcc, 0x1c,
+	0xa5, 0x7b, 0xeb, 0x0a, 0x63, 0x02, 0x36, 0x29, 0x12, 0xd5, 0x88, 0x83, 0xec, 0x80, 0x74, 0xfe,
+	0xee, 0x94, 0x38, 0xd9, 0x75, 0x86, 0x3e, 0xcd, 0x27, 0x69, 0x33, 0x01, 0x93, 0xfc, 0xbe, 0xc9,
+	0xcc, 0x7b, 0xdf, 0x1c, 0x42, 0xf6, 0xc8, 0xf5, 0xc6, 0x56, 0x50, 0x5d, 0x0b, 0xbd, 0x27, 0x04,
+	0x64, 0x18, 0x93, 0xef, 0x50, 0x52, 0x75, 0x36, 0x92, 0xaa, 0x7f, 0xab, 0x41, 0x25, 0xa6, 0xfb,
+	0x4a, 0xc6, 0x8b, 0xf8, 0x31, 0xb5, 0xc0, 0x8f, 0xe4, 0x55, 0xc7, 0x5e, 0xb6, 0x3e, 0x2f, 0xb1,
+	0x62, 0x89, 0xde, 0x86, 0x1c, 0x13, 0x81, 0xbc, 0xf7, 0x08, 0x07, 0xaa, 0x48, 0xf4, 0x19, 0x6c,
+	0x8a, 0x2d, 0xc6, 0xdf, 0x35, 0xa8, 0xf4, 0x3c, 0xfb, 0xf2, 0x12, 0x7b, 0x31, 0x77, 0x25, 0x65,
+	0xd8, 0xdb, 0x34, 0x5b, 0x07, 0x96, 0xed, 0x60, 0x8f, 0x07, 0x7d, 0x08, 0x40, 0xef, 0x43, 0xba,
+	0xe5, 0x5c, 0xf1, 0x54, 0x6b, 0x50, 0xf3, 0x25, 0x71, 0xae, 0xb5, 0x9c, 0x2b, 0xde, 0x2b, 0xb4,
+	0x9c, 0x2b, 0x72, 0x4e, 0xdd, 0xbb, 0xf4, 0xa9, 0xb0, 0x05, 0x93, 0x7e, 0x2f, 0xaa, 0xfe, 0x82,
+	0x78, 0xa5, 0x74, 0xf4, 0x0d, 0x6c, 0xc7, 0x45, 0xe1, 0x7e, 0xa9, 0x42, 0xee, 0xd8, 0xed, 0x2b,
+	0x8a, 0x8a, 0x25, 0x91, 0x81, 0x19, 0x8d, 0xb3, 0xe3, 0x2b, 0xd5, 0xbe, 0xe9, 0x7d, 0x6d, 0x99,
+	0x7d, 0xff, 0xa1, 0xc1, 0x16, 0x6f, 0xdf, 0x96, 0x9b, 0x57, 0x8d, 0x90, 0x54, 0x2c, 0x42, 0x6a,
+	0x90, 0xef, 0x06, 0x9e, 0x15, 0xe0, 0x4b, 0x56, 0xac, 0xf9, 0x05, 0x65, 0xbc, 0x05, 0xc6, 0x94,
+	0x7b, 0x56, 0x28, 0x67, 0xdf, 0xc5, 0xc5, 0xff, 0x0a, 0x2a, 0x31, 0x2d, 0x57, 0xbb, 0xf7, 0x08,
+	0x32, 0x4d, 0xfb, 0xe2, 0x42, 0x94, 0x6e, 0xf2, 0xad, 0x38, 0x20, 0xad, 0x3a, 0xc0, 0xf8, 0x85,
+	0x06, 0x7a, 0x57, 0xa6, 0x27, 0x3e, 0xcc, 0xc0, 0xc3, 0x45, 0x86, 0x55, 0x66, 0x20, 0xa9, 0xe8,
+	0x0c, 0xe4, 0x10, 0xca, 0xac, 0xb9, 0x52, 0xe6, 0x08, 0xac, 0xfd, 0x9a, 0x81, 0x2b, 0xca, 0x67,
+	0x22, 0xca, 0xff, 0x4e, 0x83, 0xbd, 0x44, 0x81, 0xb8, 0x0d, 0xde, 0x85, 0xcd, 0x33, 0x0f, 0x5f,
+	0xd9, 0xee, 0xd4, 0x1f, 0x5d, 0x4b, 0x34, 0x15, 0x30, 0x6f, 0x26, 0xa1, 0x54, 0xab, 0xa5, 0x16,
+	0x58, 0x6d, 0x1f, 0x8a, 0x4c, 0xc8, 0x21, 0x97, 0x9b, 0x44, 0x90, 0x0a, 0x52, 0x6c, 0x98, 0x89,
+	0xd8, 0xf0, 0x31, 0x6c, 0xb1, 0x6d, 0x2f, 0x71, 0x2b, 0xe7, 0x3d, 0x4c, 0x76, 0xa0, 0x12, 0xe3,
+	0xc1, 0xbb, 0xd2, 0x3f, 0x6a, 0x70, 0xf3, 0xd8, 0xed, 0x37, 0x5c, 0x67, 0x68, 0x93, 0xfe, 0x41,
+	0x36, 0x66, 0x9a, 0xd2, 0x98, 0x6d, 0x43, 0xb6, 0x1b, 0x58, 0xc1, 0xd4, 0x17, 0xe1, 0xc5, 0x56,
+	0xec, 0xe9, 0x60, 0xf9, 0x72, 0xe2, 0xc6, 0x57, 0xc4, 0x85, 0xcf, 0xb1, 0xef, 0x5b, 0x97, 0x98,
+	0xab, 0x22, 0x96, 0x62, 0xa0, 0xd4, 0xf3, 0x2c, 0xc7, 0xb7, 0xc5, 0x4c, 0xad, 0xba, 0xf6, 0x72,
+	0x03, 0xa5, 0x28, 0x95, 0xf1, 0xfb, 0x14, 0xa4, 0xf9, 0x7d, 0x74, 0x14, 0x3b, 0x38, 0xdc, 0x0e,
+	0xec, 0x32, 0x50, 0x89, 0xd7, 0x4c, 0xbe, 0x22, 0x49, 0x91, 0x8e, 0xa5, 0xf0, 0x90, 0xa7, 0x84,
+	0x35, 0x33, 0x04, 0x10, 0xaa, 0x23, 0xcb, 0x1e, 0x61, 0x36, 0x7d, 0x5b, 0x33, 0xf9, 0x0a, 0xdd,
+	0x87, 0x42, 0x37, 0xb0, 0xbc, 0xe0, 0x25, 0x85, 0x0d, 0x37, 0xa3, 0xc7, 0x50, 0x6a, 0xb8, 0xe3,
+	0xc9, 0x08, 0x4b, 0x5d, 0x97, 0x8f, 0xe1, 0x62, 0x14, 0xe8, 0x5d, 0x00, 0xe9, 0x1e, 0xbf, 0x9a,
+	0xa3, 0x19, 0xbb, 0x4c, 0xee, 0x98, 0xea, 0x37, 0x53, 0xd9, 0x43, 0xec, 0x2f, 0xae, 0x64, 0x9e,
+	0xd9, 0x9f, 0x2f, 0x8d, 0x32, 0x94, 0x9e, 0xe0, 0x40, 0x6d, 0x52, 0x6a, 0xb0, 0x2e, 0x21, 0x3c,
+	0x06, 0xf6, 0x20, 0xa3, 0x34, 0x27, 0x39, 0x7e, 0x94, 0x49, 0x81, 0xc6, 0xeb, 0x70, 0x8b, 0xed,
+	0x9f, 0xd7, 0x90, 0xbc, 0x25, 0x8e, 0x90, 0xfc, 0x76, 0xa9, 0x9f, 0x78, 0x4e, 0x91, 0xec, 0x08,
+	0xcc, 0xf8, 0xab, 0x06, 0x65, 0x96, 0x7e, 0x5f, 0xb2, 0xf9, 0x40, 0x4a, 0xf3, 0x91, 0x7f, 0xc5,
+	0x5e, 0x23, 0x4c, 0xa2, 0x99, 0x15, 0x92, 0x68, 0xa4, 0xba, 0xa1, 0xbb, 0x50, 0x6a, 0x0f, 0xf1,
+	0x78, 0xe2, 0x06, 0xa4, 0x2d, 0x21, 0x0f, 0xa8, 0x2c, 0x95, 0x34, 0x06, 0x35, 0xfe, 0xa2, 0xc1,
+	0x86, 0xa2, 0x20, 0xb7, 0xc8, 0x01, 0xe4, 0x4e, 0xa7, 0xc1, 0xc0, 0x1d, 0x8b, 0xf6, 0xb3, 0xc4,
+	0xad, 0xc2, 0xa1, 0xa6, 0x40, 0x0b, 0xdb, 0xa5, 0x66, 0x6d, 0x27, 0xdd, 0x94, 0x4e, 0x70, 0x93,
+	0xda, 0x73, 0x64, 0xe6, 0xf6, 0x1c, 0x6b, 0x4b, 0x7b, 0x0e, 0xe2, 0x8b, 0xd6, 0x37, 0xb6, 0x1f,
+	0xd8, 0xce, 0x25, 0x2f, 0x23, 0x72, 0x6d, 0x7c, 0x0b, 0x1b, 0xc2, 0x6a, 0x67, 0x96, 0x67, 0x8d,
+	0x71, 0x80, 0xbd, 0x79, 0xb5, 0x92, 0xf8, 0xd6, 0xf6, 0xb0, 0xc8, 0xe9, 0x72, 0x4d, 0x04, 0x6d,
+	0xe2, 0x0b, 0x6b, 0x3a, 0x12, 0xa5, 0x43, 0x2c, 0x59, 0xc6, 0xf4, 0x07, 0x9e, 0x3d, 0x09, 0xc2,
+	0x8a, 0xa8, 0x82, 0x8c, 0xcf, 0xa1, 0x78, 0xec, 0xf6, 0xd5, 0xbb, 0x31, 0x73, 0xf4, 0x8f, 0x00,
+	0xa4, 0x6c, 0xa2, 0x4d, 0xab, 0xa8, 0xfe, 0x96, 0x58, 0x53, 0xd9, 0x48, 0xde, 0xf1, 0xe4, 0x71,
+	0xa2, 0x70, 0x97, 0x01, 0xd3, 0x86, 0xea, 0x2c, 0x8a, 0xfb, 0xf5, 0x07, 0x50, 0x90, 0x40, 0x1e,
+	0x3e, 0xeb, 0xdc, 0x2f, 0x02, 0x6e, 0x86, 0x3b, 0x8c, 0x3f, 0xa4, 0xe0, 0xb6, 0xbc, 0x1c, 0x47,
+	0x9e, 0x3b, 0x96, 0x9b, 0x16, 0xa4, 0xf8, 0x26, 0x64, 0xa9, 0xa0, 0x42, 0x9b, 0xb7, 0x59, 0xb9,
+	0x99, 0xcf, 0xa5, 0xc6, 0xb6, 0xf3, 0x77, 0x2e, 0x5b, 0xc8, 0x38, 0x4a, 0x2f, 0x8d, 0xa3, 0xcc,
+	0xdc, 0x38, 0xfa, 0x0f, 0x63, 0x83, 0xbc, 0x79, 0x15, 0xd1, 0x56, 0x6a, 0x12, 0x3b, 0x50, 0x22,
+	0x02, 0x2d, 0xa9, 0x86, 0x77, 0xa1, 0x14, 0x53, 0x26, 0x45, 0x95, 0x89, 0x41, 0x8d, 0x4f, 0x61,
+	0x5d, 0x72, 0xfb, 0x0e, 0x23, 0xd4, 0x78, 0x93, 0xf0, 0x0d, 0x06, 0x5f, 0x2e, 0x16, 0xd3, 0xf8,
+	0x16, 0xca, 0xe1, 0xb6, 0x15, 0x5f, 0xa7, 0x0b, 0xf2, 0x83, 0xa2, 0x42, 0x7a, 0xa1, 0x0a, 0xc6,
+	0x9f, 0x34, 0xd8, 0xea, 0x06, 0x1e, 0xb6, 0xc6, 0xc7, 0x6e, 0xbf, 0xe3, 0x5e, 0xfa, 0xaf, 0xfe,
+	0xae, 0x20, 0x25, 0xd4, 0x1d, 0x8d, 0xdc, 0x9f, 0x8b, 0xc1, 0x18, 0x5b, 0x21, 0x03, 0x6e, 0x76,
+	0x6d, 0x67, 0x80, 0xa3, 0xb7, 0x2a, 0x02, 0x23, 0x9c, 0x7b, 0x96, 0x3d, 0xea, 0xd8, 0x0e, 0xf6,
+	0xe9, 0x95, 0x4a, 0x9b, 0x21, 0x80, 0x24, 0x11, 0xd1, 0x7e, 0x89, 0x4c, 0x24, 0xd6, 0xc6, 0x17,
+	0x50, 0x89, 0xc9, 0xcf, 0xcd, 0x58, 0x86, 0xf4, 0x99, 0x2b, 0xaa, 0x13, 0xf9, 0x5c, 0x22, 0x3e,
+	0x82, 0x0c, 0x39, 0x8d, 0xa7, 0x22, 0xfa, 0x6d, 0x3c, 0x12, 0x6d, 0xe7, 0x2b, 0xf6, 0x5e, 0x9b,
+	0xb0, 0xa1, 0xd0, 0x33, 0xc1, 0x0e, 0x1b, 0x50, 0x8a, 0xfa, 0x13, 0x15, 0x21, 0xd7, 0x3e, 0x69,
+	0xf7, 0xda, 0xf5, 0x4e, 0xf9, 0x06, 0x2a, 0xc0, 0x5a, 0xbd, 0xd9, 0x6c, 0x35, 0xcb, 0x1a, 0xba,
+	0x09, 0xf9, 0xe7, 0xa7, 0xcd, 0xf6, 0x51, 0xbb, 0xd5, 0x2c, 0xa7, 0xc8, 0xae, 0x66, 0xab, 0xd3,
+	0xea, 0xb5, 0x9a, 0xe5, 0xf4, 0x61, 0x0d, 0x4a, 0xd1, 0xb2, 0x85, 0xf2, 0x90, 0xa9, 0x9f, 0xf7,
+	0x4e, 0xcb, 0x37, 0xc8, 0xd7, 0x71, 0xf7, 0xf4, 0xa4, 0xac, 0x91, 0xaf, 0x1f, 0xd7, 0x9f, 0x77,
+	0xca, 0xa9, 0xc3, 0x07, 0x50, 0x54, 0x5e, 0xea, 0x84, 0xd7, 0xc9, 0xe9, 0x8b, 0xcf, 0xea, 0xed,
+	0x5e, 0xf9, 0x06, 0xba, 0x05, 0x85, 0x6e, 0xe3, 0x69, 0xab, 0x79, 0xde, 0xa1, 0xa7, 0xae, 0x43,
+	0xf1, 0xf8, 0xf4, 0xf1, 0x8b, 0x6e, 0xaf, 0x6e, 0x92, 0xb3, 0x52, 0x87, 0xf7, 0xa1, 0x14, 0x7d,
+	0xbf, 0x10, 0x72, 0xb3, 0x75, 0xd6, 0xa9, 0x37, 0x5a, 0xe5, 0x1b, 0x68, 0x17, 0x2a, 0xdd, 0x9e,
+	0x59, 0xef, 0xb5, 0x9e, 0xb4, 0x1b, 0x2f, 0x9e, 0xb7, 0xcc, 0x27, 0xad, 0x17, 0x67, 0xf5, 0x5e,
+	0xe3, 0x69, 0x59, 0x3b, 0xfc, 0x00, 0x20, 0xbc, 0x74, 0xa8, 0x04, 0x70, 0x7e, 0x72, 0xd4, 0x3e,
+	0x69, 0x77, 0x9f, 0xb6, 0x9a, 0xe5, 0x1b, 0x44, 0xbd, 0xc6, 0xe9, 0xf3, 0x33, 0xa2, 0x52, 0x59,
+	0x43, 0x00, 0xd9, 0xa3, 0x7a, 0x9b, 0x88, 0x90, 0xba, 0xf7, 0xb7, 0x5b, 0x00, 0xcf, 0xee, 0xfb,
+	0x5d, 0xf6, 0x2f, 0x19, 0x35, 0xe0, 0xa6, 0xfa, 0xfb, 0x02, 0xd1, 0x7f, 0x5f, 0x09, 0x7f, 0x43,
+	0xf4, 0xea, 0x2c, 0x82, 0x37, 0xbb, 0x37, 0xd0, 0x33, 0x6e, 0xf6, 0x90, 0xcd, 0xae, 0x0c, 0xad,
+	0x19, 0x46, 0x7a, 0x12, 0x4a, 0xb0, 0x7a, 0x57, 0x43, 0x1d, 0x58, 0x8f, 0xfd, 0x94, 0x40, 0x7a,
+	0x98, 0x8c, 0x67, 0xd8, 0xed, 0x25, 0xe2, 0xa4, 0x68, 0x1d, 0x58, 0x8f, 0xfd, 0x3b, 0x60, 0xdc,
+	0x92, 0xff, 0x57, 0xe8, 0x7b, 0x89, 0x38, 0xc9, 0xed, 0x1c, 0xd0, 0xec, 0x0c, 0x1d, 0xbd, 0xb6,
+	0xf0, 0xc7, 0x80, 0x7e, 0x67, 0x1e, 0x5a, 0x15, 0x32, 0x36, 0xdf, 0x66, 0x42, 0x26, 0x0f, 0xd9,
+	0xf5, 0xbd, 0x44, 0x9c, 0xe4, 0xf6, 0x00, 0x0a, 0x72, 0xa2, 0x88, 0xb6, 0xb8, 0xdb, 0x22, 0x23,
+	0x3d, 0xbd, 0x12, 0x83, 0x4a, 0xda, 0x4f, 0xa0, 0xa8, 0x8c, 0x0f, 0x11, 0x9d, 0xeb, 0xce, 0x4e,
+	0x19, 0xf5, 0x9d, 0x19, 0xb8, 0xe4, 0xf0, 0x18, 0x8a, 0xca, 0x5c, 0x90, 0x71, 0x98, 0x1d, 0x2a,
+	0xea, 0x3b, 0x33, 0x70, 0xe5, 0x0a, 0x7c, 0x02, 0x45, 0x65, 0xc4, 0xc7, 0x78, 0xcc, 0x4e, 0x02,
+	0xf5, 0x9d, 0x19, 0xb8, 0x94, 0xe2, 0x21, 0x40, 0x88, 0x40, 0x95, 0xe8, 0x46, 0x41, 0xbf, 0x1d,
+	0x07, 0x4b, 0xf2, 0x23, 0xb8, 0x15, 0x19, 0x62, 0xa1, 0xaa, 0x72, 0xcb, 0xa2, 0x4c, 0x76, 0x13,
+	0x30, 0x92, 0x4f, 0x1b, 0x4a, 0xd1, 0xa9, 0x0b, 0x0b, 0x8c, 0xc4, 0xa1, 0x90, 0xae, 0x27, 0xa1,
+	0x54, 0x91, 0x22, 0xf3, 0x05, 0x26, 0x52, 0xd2, 0x60, 0x45, 0xdf, 0x4d, 0xc0, 0x48, 0x3e, 0x9f,
+	0xc3, 0x66, 0xc2, 0x4b, 0x1d, 0xdd, 0x61, 0xf3, 0xd7, 0x79, 0x33, 0x05, 0xfd, 0xf5, 0xb9, 0x78,
+	0x55, 0xc2, 0xc8, 0x6b, 0x98, 0x49, 0x98, 0xf4, 0xc8, 0xd6, 0x77, 0x13, 0x30, 0x92, 0xcf, 0xfb,
+	0x90, 0xe3, 0x6f, 0x27, 0x84, 0xb8, 0x87, 0x54, 0xaf, 0x6f, 0x46, 0x60, 0x92, 0xea, 0x3d, 0xc8,
+	0x32, 0x20, 0xda, 0x08, 0x37, 0x08, 0x1a, 0xa4, 0x82, 0xd4, 0x40, 0x91, 0x1d, 0x1e, 0x0b, 0x94,
+	0xf8, 0xa3, 0x49, 0xaf, 0xc4, 0xa0, 0x92, 0xf6, 0x14, 0xca, 0xf1, 0x7e, 0x15, 0xed, 0x89, 0xa8,
+	0x48, 0x68, 0x70, 0xf5, 0xdb, 0xc9, 0x48, 0xc9, 0xd0, 0x84, 0x4a, 0x62, 0xbb, 0x89, 0xf6, 0x97,
+	0x75, 0xa2, 0xf3, 0x85, 0x7c, 0x1f, 0x72, 0xbc, 0x03, 0x43, 0xbc, 0xd7, 0x51, 0x9b, 0x3b, 0x7d,
+	0x33, 0x02, 0x93, 0x54, 0x1f, 0x41, 0x5e, 0x34, 0x4e, 0x68, 0x53, 0x86, 0xa9, 0x42, 0xb7, 0x15,
+	0x05, 0x2a, 0x81, 0xfb, 0x14, 0x6e, 0x45, 0x3a, 0x06, 0x76, 0x05, 0x92, 0x9a, 0x20, 0x7d, 0x37,
+	0x01, 0xa3, 0x70, 0x7a, 0x00, 0x05, 0x59, 0xde, 0x99, 0x6f, 0xe2, 0xdd, 0x82, 0x5e, 0x89, 0x41,
+	0x05, 0x75, 0x3f, 0x4b, 0x9f, 0xff, 0x3f, 0xfc, 0xf7, 0x00, 0xc4, 0xd3, 0x96, 0x31, 0x35, 0x25,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -827,13 +3529,29 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type K8SServiceClient interface {
 	GetConfigMap(ctx context.Context, in *GetConfigMapRequest, opts ...grpc.CallOption) (*GetConfigMapResponse, error)
+	WatchConfigMap(ctx context.Context, in *WatchConfigMapRequest, opts ...grpc.CallOption) (K8SService_WatchConfigMapClient, error)
+	CreateConfigMap(ctx context.Context, in *CreateConfigMapRequest, opts ...grpc.CallOption) (*CreateConfigMapResponse, error)
+	UpdateConfigMap(ctx context.Context, in *UpdateConfigMapRequest, opts ...grpc.CallOption) (*UpdateConfigMapResponse, error)
+	PatchConfigMapKeys(ctx context.Context, in *PatchConfigMapKeysRequest, opts ...grpc.CallOption) (*PatchConfigMapKeysResponse, error)
+	DeleteConfigMap(ctx context.Context, in *DeleteConfigMapRequest, opts ...grpc.CallOption) (*DeleteConfigMapResponse, error)
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	WatchSecret(ctx context.Context, in *WatchSecretRequest, opts ...grpc.CallOption) (K8SService_WatchSecretClient, error)
 	GetCronJobs(ctx context.Context, in *GetCronJobsRequest, opts ...grpc.CallOption) (*GetCronJobsResponse, error)
 	GetCronJob(ctx context.Context, in *GetCronJobRequest, opts ...grpc.CallOption) (*GetCronJobResponse, error)
 	CreateCronJob(ctx context.Context, in *CreateCronJobRequest, opts ...grpc.CallOption) (*CreateCronJobResponse, error)
+	TriggerCronJob(ctx context.Context, in *TriggerCronJobRequest, opts ...grpc.CallOption) (*TriggerCronJobResponse, error)
+	UpdateCronJob(ctx context.Context, in *UpdateCronJobRequest, opts ...grpc.CallOption) (*UpdateCronJobResponse, error)
+	SetCronJobSuspended(ctx context.Context, in *SetCronJobSuspendedRequest, opts ...grpc.CallOption) (*SetCronJobSuspendedResponse, error)
 	DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*DeleteCronJobResponse, error)
 	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
 	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
 	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error)
+	ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error)
+	CreateJobFromTemplate(ctx context.Context, in *CreateJobFromTemplateRequest, opts ...grpc.CallOption) (*CreateJobResponse, error)
+	WaitJob(ctx context.Context, in *WaitJobRequest, opts ...grpc.CallOption) (*WaitJobResponse, error)
+	WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (K8SService_WatchJobClient, error)
+	StreamJobLogs(ctx context.Context, in *StreamJobLogsRequest, opts ...grpc.CallOption) (K8SService_StreamJobLogsClient, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
 }
 
@@ -854,6 +3572,124 @@ func (c *k8SServiceClient) GetConfigMap(ctx context.Context, in *GetConfigMapReq
 	return out, nil
 }
 
+func (c *k8SServiceClient) WatchConfigMap(ctx context.Context, in *WatchConfigMapRequest, opts ...grpc.CallOption) (K8SService_WatchConfigMapClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_K8SService_serviceDesc.Streams[0], "/pb.K8sService/WatchConfigMap", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SServiceWatchConfigMapClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SService_WatchConfigMapClient interface {
+	Recv() (*WatchConfigMapResponse, error)
+	grpc.ClientStream
+}
+
+type k8SServiceWatchConfigMapClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SServiceWatchConfigMapClient) Recv() (*WatchConfigMapResponse, error) {
+	m := new(WatchConfigMapResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *k8SServiceClient) CreateConfigMap(ctx context.Context, in *CreateConfigMapRequest, opts ...grpc.CallOption) (*CreateConfigMapResponse, error) {
+	out := new(CreateConfigMapResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/CreateConfigMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) UpdateConfigMap(ctx context.Context, in *UpdateConfigMapRequest, opts ...grpc.CallOption) (*UpdateConfigMapResponse, error) {
+	out := new(UpdateConfigMapResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/UpdateConfigMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) PatchConfigMapKeys(ctx context.Context, in *PatchConfigMapKeysRequest, opts ...grpc.CallOption) (*PatchConfigMapKeysResponse, error) {
+	out := new(PatchConfigMapKeysResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/PatchConfigMapKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) DeleteConfigMap(ctx context.Context, in *DeleteConfigMapRequest, opts ...grpc.CallOption) (*DeleteConfigMapResponse, error) {
+	out := new(DeleteConfigMapResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/DeleteConfigMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
+	out := new(GetSecretResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) WatchSecret(ctx context.Context, in *WatchSecretRequest, opts ...grpc.CallOption) (K8SService_WatchSecretClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_K8SService_serviceDesc.Streams[1], "/pb.K8sService/WatchSecret", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SServiceWatchSecretClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SService_WatchSecretClient interface {
+	Recv() (*WatchSecretResponse, error)
+	grpc.ClientStream
+}
+
+type k8SServiceWatchSecretClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SServiceWatchSecretClient) Recv() (*WatchSecretResponse, error) {
+	m := new(WatchSecretResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *k8SServiceClient) GetCronJobs(ctx context.Context, in *GetCronJobsRequest, opts ...grpc.CallOption) (*GetCronJobsResponse, error) {
 	out := new(GetCronJobsResponse)
 	err := c.cc.Invoke(ctx, "/pb.K8sService/GetCronJobs", in, out, opts...)
@@ -881,40 +3717,158 @@ func (c *k8SServiceClient) CreateCronJob(ctx context.Context, in *CreateCronJobR
 	return out, nil
 }
 
+func (c *k8SServiceClient) TriggerCronJob(ctx context.Context, in *TriggerCronJobRequest, opts ...grpc.CallOption) (*TriggerCronJobResponse, error) {
+	out := new(TriggerCronJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/TriggerCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) UpdateCronJob(ctx context.Context, in *UpdateCronJobRequest, opts ...grpc.CallOption) (*UpdateCronJobResponse, error) {
+	out := new(UpdateCronJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/UpdateCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) SetCronJobSuspended(ctx context.Context, in *SetCronJobSuspendedRequest, opts ...grpc.CallOption) (*SetCronJobSuspendedResponse, error) {
+	out := new(SetCronJobSuspendedResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/SetCronJobSuspended", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *k8SServiceClient) DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*DeleteCronJobResponse, error) {
 	out := new(DeleteCronJobResponse)
 	err := c.cc.Invoke(ctx, "/pb.K8sService/DeleteCronJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *k8SServiceClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	out := new(GetJobsResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error) {
+	out := new(CreateJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/CreateJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error) {
+	out := new(ListJobTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/ListJobTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) CreateJobFromTemplate(ctx context.Context, in *CreateJobFromTemplateRequest, opts ...grpc.CallOption) (*CreateJobResponse, error) {
+	out := new(CreateJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/CreateJobFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) WaitJob(ctx context.Context, in *WaitJobRequest, opts ...grpc.CallOption) (*WaitJobResponse, error) {
+	out := new(WaitJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.K8sService/WaitJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SServiceClient) WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (K8SService_WatchJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_K8SService_serviceDesc.Streams[2], "/pb.K8sService/WatchJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SServiceWatchJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *k8SServiceClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
-	out := new(GetJobsResponse)
-	err := c.cc.Invoke(ctx, "/pb.K8sService/GetJobs", in, out, opts...)
-	if err != nil {
+type K8SService_WatchJobClient interface {
+	Recv() (*WatchJobResponse, error)
+	grpc.ClientStream
+}
+
+type k8SServiceWatchJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SServiceWatchJobClient) Recv() (*WatchJobResponse, error) {
+	m := new(WatchJobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *k8SServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
-	out := new(GetJobResponse)
-	err := c.cc.Invoke(ctx, "/pb.K8sService/GetJob", in, out, opts...)
+func (c *k8SServiceClient) StreamJobLogs(ctx context.Context, in *StreamJobLogsRequest, opts ...grpc.CallOption) (K8SService_StreamJobLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_K8SService_serviceDesc.Streams[3], "/pb.K8sService/StreamJobLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &k8SServiceStreamJobLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *k8SServiceClient) CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error) {
-	out := new(CreateJobResponse)
-	err := c.cc.Invoke(ctx, "/pb.K8sService/CreateJob", in, out, opts...)
-	if err != nil {
+type K8SService_StreamJobLogsClient interface {
+	Recv() (*StreamJobLogsResponse, error)
+	grpc.ClientStream
+}
+
+type k8SServiceStreamJobLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SServiceStreamJobLogsClient) Recv() (*StreamJobLogsResponse, error) {
+	m := new(StreamJobLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 func (c *k8SServiceClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error) {
@@ -929,13 +3883,29 @@ func (c *k8SServiceClient) DeleteJob(ctx context.Context, in *DeleteJobRequest,
 // K8SServiceServer is the server API for K8SService service.
 type K8SServiceServer interface {
 	GetConfigMap(context.Context, *GetConfigMapRequest) (*GetConfigMapResponse, error)
+	WatchConfigMap(*WatchConfigMapRequest, K8SService_WatchConfigMapServer) error
+	CreateConfigMap(context.Context, *CreateConfigMapRequest) (*CreateConfigMapResponse, error)
+	UpdateConfigMap(context.Context, *UpdateConfigMapRequest) (*UpdateConfigMapResponse, error)
+	PatchConfigMapKeys(context.Context, *PatchConfigMapKeysRequest) (*PatchConfigMapKeysResponse, error)
+	DeleteConfigMap(context.Context, *DeleteConfigMapRequest) (*DeleteConfigMapResponse, error)
+	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	WatchSecret(*WatchSecretRequest, K8SService_WatchSecretServer) error
 	GetCronJobs(context.Context, *GetCronJobsRequest) (*GetCronJobsResponse, error)
 	GetCronJob(context.Context, *GetCronJobRequest) (*GetCronJobResponse, error)
 	CreateCronJob(context.Context, *CreateCronJobRequest) (*CreateCronJobResponse, error)
+	TriggerCronJob(context.Context, *TriggerCronJobRequest) (*TriggerCronJobResponse, error)
+	UpdateCronJob(context.Context, *UpdateCronJobRequest) (*UpdateCronJobResponse, error)
+	SetCronJobSuspended(context.Context, *SetCronJobSuspendedRequest) (*SetCronJobSuspendedResponse, error)
 	DeleteCronJob(context.Context, *DeleteCronJobRequest) (*DeleteCronJobResponse, error)
 	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
 	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
 	CreateJob(context.Context, *CreateJobRequest) (*CreateJobResponse, error)
+	ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error)
+	CreateJobFromTemplate(context.Context, *CreateJobFromTemplateRequest) (*CreateJobResponse, error)
+	WaitJob(context.Context, *WaitJobRequest) (*WaitJobResponse, error)
+	WatchJob(*WatchJobRequest, K8SService_WatchJobServer) error
+	StreamJobLogs(*StreamJobLogsRequest, K8SService_StreamJobLogsServer) error
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
 }
 
@@ -961,6 +3931,156 @@ func _K8SService_GetConfigMap_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SService_WatchConfigMap_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigMapRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SServiceServer).WatchConfigMap(m, &k8SServiceWatchConfigMapServer{stream})
+}
+
+type K8SService_WatchConfigMapServer interface {
+	Send(*WatchConfigMapResponse) error
+	grpc.ServerStream
+}
+
+type k8SServiceWatchConfigMapServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SServiceWatchConfigMapServer) Send(m *WatchConfigMapResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SService_CreateConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).CreateConfigMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/CreateConfigMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).CreateConfigMap(ctx, req.(*CreateConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_UpdateConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).UpdateConfigMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/UpdateConfigMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).UpdateConfigMap(ctx, req.(*UpdateConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_PatchConfigMapKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchConfigMapKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).PatchConfigMapKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/PatchConfigMapKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).PatchConfigMapKeys(ctx, req.(*PatchConfigMapKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_DeleteConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).DeleteConfigMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/DeleteConfigMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).DeleteConfigMap(ctx, req.(*DeleteConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/GetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).GetSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/ListSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).ListSecrets(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_WatchSecret_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSecretRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SServiceServer).WatchSecret(m, &k8SServiceWatchSecretServer{stream})
+}
+
+type K8SService_WatchSecretServer interface {
+	Send(*WatchSecretResponse) error
+	grpc.ServerStream
+}
+
+type k8SServiceWatchSecretServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SServiceWatchSecretServer) Send(m *WatchSecretResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _K8SService_GetCronJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCronJobsRequest)
 	if err := dec(in); err != nil {
@@ -1015,6 +4135,60 @@ func _K8SService_CreateCronJob_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SService_TriggerCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).TriggerCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/TriggerCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).TriggerCronJob(ctx, req.(*TriggerCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_UpdateCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).UpdateCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/UpdateCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).UpdateCronJob(ctx, req.(*UpdateCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_SetCronJobSuspended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCronJobSuspendedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).SetCronJobSuspended(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/SetCronJobSuspended",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).SetCronJobSuspended(ctx, req.(*SetCronJobSuspendedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _K8SService_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCronJobRequest)
 	if err := dec(in); err != nil {
@@ -1087,6 +4261,102 @@ func _K8SService_CreateJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SService_ListJobTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).ListJobTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/ListJobTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).ListJobTemplates(ctx, req.(*ListJobTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_CreateJobFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).CreateJobFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/CreateJobFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).CreateJobFromTemplate(ctx, req.(*CreateJobFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_WaitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SServiceServer).WaitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.K8sService/WaitJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SServiceServer).WaitJob(ctx, req.(*WaitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SService_WatchJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SServiceServer).WatchJob(m, &k8SServiceWatchJobServer{stream})
+}
+
+type K8SService_WatchJobServer interface {
+	Send(*WatchJobResponse) error
+	grpc.ServerStream
+}
+
+type k8SServiceWatchJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SServiceWatchJobServer) Send(m *WatchJobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SService_StreamJobLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJobLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SServiceServer).StreamJobLogs(m, &k8SServiceStreamJobLogsServer{stream})
+}
+
+type K8SService_StreamJobLogsServer interface {
+	Send(*StreamJobLogsResponse) error
+	grpc.ServerStream
+}
+
+type k8SServiceStreamJobLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SServiceStreamJobLogsServer) Send(m *StreamJobLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _K8SService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteJobRequest)
 	if err := dec(in); err != nil {
@@ -1113,6 +4383,30 @@ var _K8SService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfigMap",
 			Handler:    _K8SService_GetConfigMap_Handler,
 		},
+		{
+			MethodName: "CreateConfigMap",
+			Handler:    _K8SService_CreateConfigMap_Handler,
+		},
+		{
+			MethodName: "UpdateConfigMap",
+			Handler:    _K8SService_UpdateConfigMap_Handler,
+		},
+		{
+			MethodName: "PatchConfigMapKeys",
+			Handler:    _K8SService_PatchConfigMapKeys_Handler,
+		},
+		{
+			MethodName: "DeleteConfigMap",
+			Handler:    _K8SService_DeleteConfigMap_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _K8SService_GetSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _K8SService_ListSecrets_Handler,
+		},
 		{
 			MethodName: "GetCronJobs",
 			Handler:    _K8SService_GetCronJobs_Handler,
@@ -1125,6 +4419,18 @@ var _K8SService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCronJob",
 			Handler:    _K8SService_CreateCronJob_Handler,
 		},
+		{
+			MethodName: "TriggerCronJob",
+			Handler:    _K8SService_TriggerCronJob_Handler,
+		},
+		{
+			MethodName: "UpdateCronJob",
+			Handler:    _K8SService_UpdateCronJob_Handler,
+		},
+		{
+			MethodName: "SetCronJobSuspended",
+			Handler:    _K8SService_SetCronJobSuspended_Handler,
+		},
 		{
 			MethodName: "DeleteCronJob",
 			Handler:    _K8SService_DeleteCronJob_Handler,
@@ -1141,11 +4447,44 @@ var _K8SService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateJob",
 			Handler:    _K8SService_CreateJob_Handler,
 		},
+		{
+			MethodName: "ListJobTemplates",
+			Handler:    _K8SService_ListJobTemplates_Handler,
+		},
+		{
+			MethodName: "CreateJobFromTemplate",
+			Handler:    _K8SService_CreateJobFromTemplate_Handler,
+		},
+		{
+			MethodName: "WaitJob",
+			Handler:    _K8SService_WaitJob_Handler,
+		},
 		{
 			MethodName: "DeleteJob",
 			Handler:    _K8SService_DeleteJob_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConfigMap",
+			Handler:       _K8SService_WatchConfigMap_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSecret",
+			Handler:       _K8SService_WatchSecret_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJob",
+			Handler:       _K8SService_WatchJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamJobLogs",
+			Handler:       _K8SService_StreamJobLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "k8s_service.proto",
 }
@@ -1,15 +1,35 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/Tlantic/k8s-sidecar/internal/manager"
 	"github.com/Tlantic/k8s-sidecar/internal/pb"
 	"github.com/Tlantic/k8s-sidecar/pkg/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultShutdownTimeout bounds the drain of in-flight calls when SIDECAR_SHUTDOWN_TIMEOUT is unset
+	defaultShutdownTimeout = 30 * time.Second
+	// defaultHealthInterval is the period of the API server health checks when SIDECAR_HEALTH_INTERVAL is unset
+	defaultHealthInterval = 10 * time.Second
 )
 
 func main() {
@@ -21,22 +41,155 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
+	tracerProvider, err := server.NewTracerProvider(context.Background(), os.Getenv("OTEL_TRACES_EXPORTER"))
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+	if tracerProvider != nil {
+		otel.SetTracerProvider(tracerProvider)
+	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	// tracing and metrics wrap the error translation so they record the codes seen by clients
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(server.UnaryTracingInterceptor, server.UnaryMetricsInterceptor, server.UnaryErrorInterceptor),
+		grpc.ChainStreamInterceptor(server.StreamTracingInterceptor, server.StreamMetricsInterceptor, server.StreamErrorInterceptor),
+	)
+
+	metricsPort := ":9090"
+	if os.Getenv("SIDECAR_METRICS_PORT") != "" {
+		metricsPort = fmt.Sprintf(":%s", os.Getenv("SIDECAR_METRICS_PORT"))
+	}
+	metricsLis, err := net.Listen("tcp", metricsPort)
+	if err != nil {
+		log.Fatalf("failed to listen for metrics: %v", err)
+	}
+
+	idempotencyWindow, err := durationEnv("K8S_IDEMPOTENCY_WINDOW")
+	if err != nil {
+		log.Fatalf("invalid K8S_IDEMPOTENCY_WINDOW: %v", err)
+	}
+	shutdownTimeout, err := durationEnv("SIDECAR_SHUTDOWN_TIMEOUT")
+	if err != nil {
+		log.Fatalf("invalid SIDECAR_SHUTDOWN_TIMEOUT: %v", err)
+	}
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = defaultShutdownTimeout
+	}
+	healthInterval, err := durationEnv("SIDECAR_HEALTH_INTERVAL")
+	if err != nil {
+		log.Fatalf("invalid SIDECAR_HEALTH_INTERVAL: %v", err)
+	}
+	if healthInterval <= 0 {
+		healthInterval = defaultHealthInterval
+	}
 
 	kubeManager, err := manager.NewKube(&manager.KubeManagerOptions{
-		Config:    os.Getenv("KUBECONFIG"),
-		Namespace: os.Getenv("K8S_NAMESPACE"),
-		Timeout:   10,
+		Config:                   os.Getenv("KUBECONFIG"),
+		Namespace:                os.Getenv("K8S_NAMESPACE"),
+		Timeout:                  10,
+		AllowedSecrets:           splitList(os.Getenv("K8S_ALLOWED_SECRETS")),
+		DefaultConcurrencyPolicy: os.Getenv("K8S_DEFAULT_CONCURRENCY_POLICY"),
+		TemplateLabel:            os.Getenv("K8S_TEMPLATE_LABEL"),
+		IdempotencyWindow:        idempotencyWindow,
 	})
 	if err != nil {
 		panic(err)
 	}
 
+	if err := server.RegisterMetrics(prometheus.DefaultRegisterer, kubeManager); err != nil {
+		log.Fatalf("failed to register metrics: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	metricsServer := &http.Server{Handler: mux}
+	go func() {
+		log.Printf("serving metrics on port %s\n", metricsPort)
+		if err := metricsServer.Serve(metricsLis); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to serve metrics: %v", err)
+		}
+	}()
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
 	pb.RegisterK8SServiceServer(s, server.NewK8sService(kubeManager))
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
+
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	go server.MonitorHealth(monitorCtx, kubeManager, healthServer, healthInterval)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	stopped := make(chan struct{})
+	go func() {
+		sig := <-signals
+		log.Printf("received %s, shutting down", sig)
+		stopMonitor()
+		shutdown(s, healthServer, kubeManager, shutdownTimeout)
+		// keep metrics available until the drain is over
+		metricsServer.Close()
+		if tracerProvider != nil {
+			flushTraces(tracerProvider, shutdownTimeout)
+		}
+		close(stopped)
+	}()
+
 	log.Printf("listening on port %s\n", port)
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
+	// Serve returns as soon as the listener is closed, wait for the drain to finish
+	<-stopped
+	log.Printf("server stopped")
+}
+
+// shutdown reports NOT_SERVING, ends the watches so their streams complete and drains in-flight
+// calls for at most timeout before closing the remaining ones
+func shutdown(s *grpc.Server, healthServer *health.Server, kubeManager *manager.KubeManager, timeout time.Duration) {
+	healthServer.Shutdown()
+	kubeManager.Close()
+
+	drained := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(timeout):
+		log.Printf("in-flight calls still running after %s, closing them", timeout)
+		s.Stop()
+		<-drained
+	}
+}
+
+// flushTraces exports the spans still buffered by tracerProvider, giving up after timeout
+func flushTraces(tracerProvider *sdktrace.TracerProvider, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		log.Printf("failed to flush traces: %v", err)
+	}
+}
+
+// splitList parses a comma separated environment value, ignoring empty entries
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// durationEnv parses a duration such as "15m" from the environment, zero when unset
+func durationEnv(key string) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
 }
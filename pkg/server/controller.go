@@ -5,13 +5,35 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/Tlantic/k8s-sidecar/internal/manager"
 	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"time"
 )
 
 var _ pb.K8SServiceServer = (*K8sService)(nil)
 
+const (
+	// manualInstantiateAnnotation marks Jobs created from a CronJob outside of its schedule
+	manualInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+	// nameSuffixAnnotation asks for a "timestamp" or "random" suffix to be appended to the name of a Job template
+	nameSuffixAnnotation = "k8s-sidecar.tlantic.com/name-suffix"
+)
+
 type K8sService struct {
 	manager *manager.KubeManager
 }
@@ -21,17 +43,152 @@ func NewK8sService(manager *manager.KubeManager) *K8sService {
 }
 
 func (s *K8sService) GetConfigMap(ctx context.Context, in *pb.GetConfigMapRequest) (*pb.GetConfigMapResponse, error) {
-	data, err := s.manager.GetConfigMap(ctx, in.Key)
+	name := in.Name
+	if name == "" {
+		name = in.Key
+	}
+	data, err := s.manager.GetConfigMap(ctx, name)
 
 	if err != nil {
 		return &pb.GetConfigMapResponse{}, err
 	}
 
 	return &pb.GetConfigMapResponse{
-		Config: data.Data[in.Key],
+		Config:    data.Data[in.Key],
+		ConfigMap: toConfigMap(data),
 	}, nil
 }
 
+func (s *K8sService) WatchConfigMap(in *pb.WatchConfigMapRequest, stream pb.K8SService_WatchConfigMapServer) error {
+	ctx := stream.Context()
+
+	names, entry, err := watchedConfigMaps(in)
+	if err != nil {
+		return err
+	}
+
+	// the initial state comes from the informer cache the events follow, so none is stale or repeated
+	initial, events, err := s.manager.WatchConfigMaps(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	for _, data := range initial {
+		if err := stream.Send(&pb.WatchConfigMapResponse{
+			Type:      pb.WatchEventType_INITIAL,
+			Key:       data.Name,
+			Config:    entry(data),
+			ConfigMap: toConfigMap(data),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return s.watchEnded(ctx)
+			}
+			data := event.Object.(*v1.ConfigMap)
+			if err := stream.Send(&pb.WatchConfigMapResponse{
+				Type:      watchEventType(event.Type),
+				Key:       data.Name,
+				Config:    entry(data),
+				ConfigMap: toConfigMap(data),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchedConfigMaps resolves the ConfigMaps a watch follows and the entry each event copies to Config.
+// Legacy Keys name a ConfigMap and its entry at once.
+func watchedConfigMaps(in *pb.WatchConfigMapRequest) ([]string, func(*v1.ConfigMap) string, error) {
+	if len(in.Names) == 0 {
+		if in.Key != "" {
+			return nil, nil, status.Error(codes.InvalidArgument, "key requires names")
+		}
+		return in.Keys, func(data *v1.ConfigMap) string { return data.Data[data.Name] }, nil
+	}
+	if len(in.Keys) != 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "names and keys are mutually exclusive")
+	}
+	return in.Names, func(data *v1.ConfigMap) string { return data.Data[in.Key] }, nil
+}
+
+func (s *K8sService) CreateConfigMap(ctx context.Context, in *pb.CreateConfigMapRequest) (*pb.CreateConfigMapResponse, error) {
+	if in.ConfigMap == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing config map")
+	}
+
+	data, err := s.manager.CreateConfigMap(ctx, fromConfigMap(in.ConfigMap), in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateConfigMapResponse{
+		ConfigMap: toConfigMap(data),
+	}, nil
+}
+
+func (s *K8sService) UpdateConfigMap(ctx context.Context, in *pb.UpdateConfigMapRequest) (*pb.UpdateConfigMapResponse, error) {
+	if in.ConfigMap == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing config map")
+	}
+
+	data, err := s.manager.UpdateConfigMap(ctx, fromConfigMap(in.ConfigMap), in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateConfigMapResponse{
+		ConfigMap: toConfigMap(data),
+	}, nil
+}
+
+func (s *K8sService) PatchConfigMapKeys(ctx context.Context, in *pb.PatchConfigMapKeysRequest) (*pb.PatchConfigMapKeysResponse, error) {
+	data, err := s.manager.PatchConfigMapKeys(ctx, in.Name, in.Set, in.Remove, in.ResourceVersion, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PatchConfigMapKeysResponse{
+		ConfigMap: toConfigMap(data),
+	}, nil
+}
+
+func (s *K8sService) DeleteConfigMap(ctx context.Context, in *pb.DeleteConfigMapRequest) (*pb.DeleteConfigMapResponse, error) {
+	err := s.manager.DeleteConfigMap(ctx, in.Name, in.ResourceVersion, in.DryRun)
+	return &pb.DeleteConfigMapResponse{}, err
+}
+
+// watchEnded explains why the events of a watch stopped: the client went away, the sidecar is
+// shutting down or the subscription fell behind and was dropped
+func (s *K8sService) watchEnded(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	if s.manager.Closed() {
+		return status.Error(codes.Unavailable, "server shutting down, resubscribe")
+	}
+	return status.Error(codes.Aborted, "watch fell behind, resubscribe")
+}
+
+func watchEventType(eventType watch.EventType) pb.WatchEventType {
+	switch eventType {
+	case watch.Added:
+		return pb.WatchEventType_ADDED
+	case watch.Deleted:
+		return pb.WatchEventType_DELETED
+	default:
+		return pb.WatchEventType_MODIFIED
+	}
+}
+
 func (s *K8sService) GetCronJobs(ctx context.Context, _ *pb.GetCronJobsRequest) (*pb.GetCronJobsResponse, error) {
 	list, err := s.manager.ListCronJobs(ctx)
 	if err != nil {
@@ -39,10 +196,8 @@ func (s *K8sService) GetCronJobs(ctx context.Context, _ *pb.GetCronJobsRequest)
 	}
 
 	cronJobs := make([]*pb.CronJob, len(list.Items))
-	for index, item := range list.Items {
-		cronJobs[index] = &pb.CronJob{
-			Name: item.Name,
-		}
+	for index := range list.Items {
+		cronJobs[index] = toCronJob(&list.Items[index])
 	}
 
 	return &pb.GetCronJobsResponse{
@@ -57,25 +212,319 @@ func (s *K8sService) GetCronJob(ctx context.Context, in *pb.GetCronJobRequest) (
 	}
 
 	return &pb.GetCronJobResponse{
-		CronJob: &pb.CronJob{
-			Name: cronJob.Name,
-		},
+		CronJob: toCronJob(cronJob),
 	}, nil
 }
 
 func (s *K8sService) CreateCronJob(ctx context.Context, in *pb.CreateCronJobRequest) (*pb.CreateCronJobResponse, error) {
-	var jobTemplateData batchv1.CronJob
-	err := json.Unmarshal([]byte(in.Template), &jobTemplateData)
+	templates, err := decodeCronJobs(in.Template, in.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := overrideConcurrencyPolicy(templates, in.ConcurrencyPolicy); err != nil {
+		return nil, err
+	}
+
+	created := make([]*batchv1.CronJob, len(templates))
+	for index, template := range templates {
+		if created[index], err = s.manager.CreateCronJob(ctx, template, in.DryRun); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.DryRun {
+		objects, err := objectsJSON(created)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CreateCronJobResponse{
+			Objects: objects,
+			Created: toIdentities(created),
+		}, nil
+	}
+
+	var condition manager.CronJobWaitCondition
+	switch in.Wait {
+	case pb.CronJobWait_SCHEDULED:
+		condition = manager.CronJobScheduled
+	case pb.CronJobWait_JOB_STARTED:
+		condition = manager.CronJobJobStarted
+	default:
+		return &pb.CreateCronJobResponse{
+			Created: toIdentities(created),
+		}, nil
+	}
+
+	ctx, cancel := withTimeout(ctx, in.WaitTimeoutSeconds)
+	defer cancel()
+
+	// wait on the names the API server assigned, templates may rely on generateName
+	cronJobs := make([]*pb.CronJob, len(created))
+	for index, cronJob := range created {
+		cronJob, err := s.manager.WaitForCronJob(ctx, cronJob.Name, condition)
+		if err != nil {
+			return nil, err
+		}
+		cronJobs[index] = toCronJob(cronJob)
+	}
+
+	return &pb.CreateCronJobResponse{
+		CronJob:  cronJobs[0],
+		CronJobs: cronJobs,
+		Created:  toIdentities(created),
+	}, nil
+}
+
+func (s *K8sService) TriggerCronJob(ctx context.Context, in *pb.TriggerCronJobRequest) (*pb.TriggerCronJobResponse, error) {
+	cronJob, err := s.manager.GetCronJob(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	job := newManualJob(cronJob)
+	if err := overrideContainers(&job.Spec.Template.Spec, in.Container, in.Env, in.Args); err != nil {
+		return nil, err
+	}
+
+	created, err := s.manager.CreateJob(ctx, job, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.TriggerCronJobResponse{
+		JobName: created.Name,
+		Created: toIdentity(created),
+	}
+	if in.DryRun {
+		if response.Object, err = objectJSON(created); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// overrideConcurrencyPolicy sets the concurrency policy of every template when policy is not empty
+func overrideConcurrencyPolicy(templates []*batchv1.CronJob, policy string) error {
+	if policy == "" {
+		return nil
+	}
+	if !manager.ValidConcurrencyPolicy(batchv1.ConcurrencyPolicy(policy)) {
+		return status.Errorf(codes.InvalidArgument, "invalid concurrency policy %q", policy)
+	}
+	for _, template := range templates {
+		template.Spec.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(policy)
+	}
+	return nil
+}
+
+// newManualJob instantiates a Job from the template of cronJob the way `kubectl create job --from=cronjob` does.
+// The name ends in a random suffix, so runs started within the same second do not collide.
+func newManualJob(cronJob *batchv1.CronJob) *batchv1.Job {
+	annotations := map[string]string{manualInstantiateAnnotation: "manual"}
+	for key, value := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+	labels := make(map[string]string, len(cronJob.Spec.JobTemplate.Labels))
+	for key, value := range cronJob.Spec.JobTemplate.Labels {
+		labels[key] = value
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        suffixedName(cronJob.Name, "-manual-"+utilrand.String(5)),
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+}
+
+// suffixedName appends suffix to name, truncating name so that the result still fits
+// the job-name label of the pods, which is limited to 63 characters
+func suffixedName(name, suffix string) string {
+	if len(name)+len(suffix) > validation.DNS1123LabelMaxLength {
+		name = name[:validation.DNS1123LabelMaxLength-len(suffix)]
+	}
+	return name + suffix
+}
+
+// uniqueName appends the suffix requested by the name suffix annotation of job to its name.
+// Jobs without the annotation are left as is, including those relying on generateName.
+func uniqueName(job *batchv1.Job, now time.Time) error {
+	kind, ok := job.Annotations[nameSuffixAnnotation]
+	if !ok {
+		return nil
+	}
+	if job.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "%s requires metadata.name, use metadata.generateName instead", nameSuffixAnnotation)
+	}
+
+	switch kind {
+	case "timestamp":
+		job.Name = suffixedName(job.Name, fmt.Sprintf("-%d", now.Unix()))
+	case "random":
+		job.Name = suffixedName(job.Name, "-"+utilrand.String(5))
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown %s %q, expected timestamp or random", nameSuffixAnnotation, kind)
+	}
+	return nil
+}
+
+// overrideContainers sets env and replaces args of the named container, or of every container when name is empty
+func overrideContainers(spec *v1.PodSpec, name string, env map[string]string, args []string) error {
+	found := false
+	for index := range spec.Containers {
+		container := &spec.Containers[index]
+		if name != "" && container.Name != name {
+			continue
+		}
+		found = true
+
+		// map order is random, add new variables in a stable order
+		keys := make([]string, 0, len(env))
+		for key := range env {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			container.Env = setEnv(container.Env, key, env[key])
+		}
+		if len(args) > 0 {
+			container.Args = args
+		}
+	}
+
+	if !found {
+		return status.Errorf(codes.InvalidArgument, "container %q not found in job template", name)
+	}
+	return nil
+}
+
+func setEnv(env []v1.EnvVar, key, value string) []v1.EnvVar {
+	for index := range env {
+		if env[index].Name == key {
+			env[index] = v1.EnvVar{Name: key, Value: value}
+			return env
+		}
+	}
+	return append(env, v1.EnvVar{Name: key, Value: value})
+}
+
+func (s *K8sService) UpdateCronJob(ctx context.Context, in *pb.UpdateCronJobRequest) (*pb.UpdateCronJobResponse, error) {
+	var (
+		before, after *batchv1.CronJob
+		err           error
+	)
+	switch in.Strategy {
+	case pb.UpdateStrategy_STRATEGIC_MERGE_PATCH:
+		if in.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing cron job name")
+		}
+		// YAML is a superset of JSON, so converting handles both formats
+		patch, err := yaml.YAMLToJSON([]byte(in.Template))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid patch: %v", err)
+		}
+		if before, after, err = s.manager.PatchCronJob(ctx, in.Name, patch, in.ResourceVersion, in.DryRun); err != nil {
+			return nil, err
+		}
+	default:
+		templates, err := decodeCronJobs(in.Template, in.Format)
+		if err != nil {
+			return nil, err
+		}
+		if len(templates) != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "expected a single cron job, got %d", len(templates))
+		}
+		jobTemplateData := templates[0]
+		if in.Name != "" && jobTemplateData.Name == "" {
+			jobTemplateData.Name = in.Name
+		}
+		if in.Name != "" && jobTemplateData.Name != in.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "template name %q does not match %q", jobTemplateData.Name, in.Name)
+		}
+		if before, after, err = s.manager.ReplaceCronJob(ctx, jobTemplateData, in.ResourceVersion, in.DryRun); err != nil {
+			return nil, err
+		}
+	}
+
+	diff, err := cronJobDiff(before, after)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.manager.CreateCronJob(ctx, &jobTemplateData, true)
-	return &pb.CreateCronJobResponse{}, err
+	response := &pb.UpdateCronJobResponse{
+		CronJob: toCronJob(after),
+		Diff:    diff,
+	}
+	if in.DryRun {
+		if response.Object, err = objectJSON(after); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// cronJobDiff describes the changes to the labels, annotations and spec of a CronJob as a strategic merge patch
+func cronJobDiff(before, after *batchv1.CronJob) (string, error) {
+	trim := func(cronJob *batchv1.CronJob) ([]byte, error) {
+		return json.Marshal(&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      cronJob.Labels,
+				Annotations: cronJob.Annotations,
+			},
+			Spec: cronJob.Spec,
+		})
+	}
+
+	original, err := trim(before)
+	if err != nil {
+		return "", err
+	}
+	modified, err := trim(after)
+	if err != nil {
+		return "", err
+	}
+
+	diff, err := strategicpatch.CreateTwoWayMergePatch(original, modified, batchv1.CronJob{})
+	return string(diff), err
+}
+
+func (s *K8sService) SetCronJobSuspended(ctx context.Context, in *pb.SetCronJobSuspendedRequest) (*pb.SetCronJobSuspendedResponse, error) {
+	previous, cronJob, err := s.manager.SetCronJobSuspended(ctx, in.Name, in.Suspend, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+	if in.Suspend && in.DeleteActiveJobs {
+		for _, ref := range cronJob.Status.Active {
+			if err := s.manager.DeleteJob(ctx, ref.Name, in.DryRun); err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			deleted = append(deleted, ref.Name)
+		}
+	}
+
+	response := &pb.SetCronJobSuspendedResponse{
+		PreviouslySuspended: previous,
+		CronJob:             toCronJob(cronJob),
+		DeletedJobs:         deleted,
+	}
+	if in.DryRun {
+		if response.Object, err = objectJSON(cronJob); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 func (s *K8sService) DeleteCronJob(ctx context.Context, in *pb.DeleteCronJobRequest) (*pb.DeleteCronJobResponse, error) {
-	err := s.manager.DeleteCronJob(ctx, in.Name)
+	err := s.manager.DeleteCronJob(ctx, in.Name, in.DryRun)
 	return &pb.DeleteCronJobResponse{}, err
 }
 
@@ -85,43 +534,241 @@ func (s *K8sService) GetJobs(ctx context.Context, _ *pb.GetJobsRequest) (*pb.Get
 		return nil, err
 	}
 
-	cronJobs := make([]*pb.Job, len(list.Items))
-	for index, item := range list.Items {
-		cronJobs[index] = &pb.Job{
-			Name: item.Name,
-		}
+	jobs := make([]*pb.Job, len(list.Items))
+	for index := range list.Items {
+		jobs[index] = toJob(&list.Items[index])
 	}
 
 	return &pb.GetJobsResponse{
-		Jobs: cronJobs,
+		Jobs: jobs,
 	}, nil
 }
 
 func (s *K8sService) GetJob(ctx context.Context, in *pb.GetJobRequest) (*pb.GetJobResponse, error) {
-	cronJob, err := s.manager.GetJob(ctx, in.Id)
+	job, err := s.manager.GetJob(ctx, in.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetJobResponse{
-		Job: &pb.Job{
-			Name: cronJob.Name,
-		},
+		Job: toJob(job),
 	}, nil
 }
 
 func (s *K8sService) CreateJob(ctx context.Context, in *pb.CreateJobRequest) (*pb.CreateJobResponse, error) {
-	var jobTemplateData batchv1.Job
-	err := json.Unmarshal([]byte(in.Template), &jobTemplateData)
+	templates, err := decodeJobs(in.Template, in.Format)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.manager.CreateJob(ctx, &jobTemplateData, true)
-	return &pb.CreateJobResponse{}, err
+	return s.createJobs(ctx, templates, in.IdempotencyKey, in.Wait, in.WaitTimeoutSeconds, in.DryRun)
+}
+
+// createJobs creates every job and, when wait is set, waits for all of them to finish.
+// Names are made unique first when requested, waits use the final names assigned by the API server.
+// When jobs were already created for idempotencyKey, those are used instead of creating new ones.
+// Dry runs return the jobs the API server would store instead of waiting.
+func (s *K8sService) createJobs(ctx context.Context, jobs []*batchv1.Job, idempotencyKey string, wait bool, waitTimeoutSeconds int64, dryRun bool) (*pb.CreateJobResponse, error) {
+	// with a key the Jobs are named after it instead, so resubmissions collide
+	if idempotencyKey == "" {
+		now := time.Now()
+		for _, job := range jobs {
+			if err := uniqueName(job, now); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// with a key, Jobs created by an earlier or concurrent submission are returned instead of failing
+	// with AlreadyExists, so a retry after a partial failure only creates the missing Jobs
+	created := make([]*batchv1.Job, len(jobs))
+	found := 0
+	for index, job := range jobs {
+		var (
+			existed bool
+			err     error
+		)
+		if idempotencyKey != "" {
+			created[index], existed, err = s.manager.CreateIdempotentJob(ctx, job, idempotencyKey, index, dryRun)
+		} else {
+			created[index], err = s.manager.CreateJob(ctx, job, dryRun)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if existed {
+			found++
+		}
+	}
+	existing := found == len(jobs)
+
+	if dryRun {
+		objects, err := objectsJSON(created)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CreateJobResponse{
+			Objects:  objects,
+			Created:  toIdentities(created),
+			Existing: existing,
+		}, nil
+	}
+
+	if !wait {
+		return &pb.CreateJobResponse{
+			Created:  toIdentities(created),
+			Existing: existing,
+		}, nil
+	}
+
+	ctx, cancel := withTimeout(ctx, waitTimeoutSeconds)
+	defer cancel()
+
+	response := &pb.CreateJobResponse{
+		Outcome:  pb.JobOutcome_COMPLETE,
+		Jobs:     make([]*pb.Job, len(created)),
+		Created:  toIdentities(created),
+		Existing: existing,
+	}
+	for index, job := range created {
+		outcome, waited, err := s.waitJob(ctx, job.Name, 0)
+		if err != nil {
+			return nil, err
+		}
+		if outcome == pb.JobOutcome_FAILED {
+			response.Outcome = outcome
+		}
+		response.Jobs[index] = waited
+	}
+	response.Job = response.Jobs[0]
+
+	return response, nil
+}
+
+func (s *K8sService) WaitJob(ctx context.Context, in *pb.WaitJobRequest) (*pb.WaitJobResponse, error) {
+	outcome, job, err := s.waitJob(ctx, in.Name, in.TimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WaitJobResponse{
+		Outcome: outcome,
+		Job:     job,
+	}, nil
+}
+
+func (s *K8sService) waitJob(ctx context.Context, name string, timeoutSeconds int64) (pb.JobOutcome, *pb.Job, error) {
+	ctx, cancel := withTimeout(ctx, timeoutSeconds)
+	defer cancel()
+
+	job, err := s.manager.WaitForJob(ctx, name)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			countJobOutcome(pb.JobOutcome_UNFINISHED)
+		}
+		return pb.JobOutcome_UNFINISHED, nil, err
+	}
+
+	outcome := jobOutcome(job)
+	countJobOutcome(outcome)
+	return outcome, toJob(job), nil
+}
+
+func (s *K8sService) WatchJob(in *pb.WatchJobRequest, stream pb.K8SService_WatchJobServer) error {
+	ctx := stream.Context()
+
+	initial, events, err := s.manager.WatchJobs(ctx, []string{in.Name})
+	if err != nil {
+		return err
+	}
+
+	// last is the status sent most recently, nil until the first event is sent
+	var last *batchv1.JobStatus
+	if len(initial) > 0 {
+		if done, err := sendJobEvent(stream, pb.WatchEventType_INITIAL, initial[0]); done || err != nil {
+			return err
+		}
+		last = &initial[0].Status
+	} else if _, err := s.manager.GetJob(ctx, in.Name); err != nil {
+		// a Job created moments ago is not cached yet, its ADDED event then comes first
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return s.watchEnded(ctx)
+			}
+			job := event.Object.(*batchv1.Job)
+			if event.Type != watch.Deleted && last != nil && equality.Semantic.DeepEqual(*last, job.Status) {
+				continue
+			}
+			last = &job.Status
+			if done, err := sendJobEvent(stream, watchEventType(event.Type), job); done || err != nil {
+				return err
+			}
+			if event.Type == watch.Deleted {
+				return nil
+			}
+		}
+	}
+}
+
+// sendJobEvent streams the state of job, reporting done once the job has finished
+func sendJobEvent(stream pb.K8SService_WatchJobServer, eventType pb.WatchEventType, job *batchv1.Job) (bool, error) {
+	outcome := jobOutcome(job)
+	err := stream.Send(&pb.WatchJobResponse{
+		Type:    eventType,
+		Job:     toJob(job),
+		Outcome: outcome,
+	})
+	return outcome != pb.JobOutcome_UNFINISHED, err
+}
+
+func (s *K8sService) StreamJobLogs(in *pb.StreamJobLogsRequest, stream pb.K8SService_StreamJobLogsServer) error {
+	options := manager.JobLogOptions{
+		Container:    in.Container,
+		Follow:       in.Follow,
+		SinceSeconds: in.SinceSeconds,
+		TailLines:    in.TailLines,
+		Previous:     in.Previous,
+	}
+
+	return s.manager.StreamJobLogs(stream.Context(), in.Name, options, func(line manager.LogLine) error {
+		prefix := line.Pod
+		if in.Container == "" {
+			prefix = fmt.Sprintf("%s/%s", line.Pod, line.Container)
+		}
+		return stream.Send(&pb.StreamJobLogsResponse{
+			Pod:       line.Pod,
+			Container: line.Container,
+			Line:      fmt.Sprintf("[%s] %s", prefix, line.Text),
+		})
+	})
+}
+
+// withTimeout bounds ctx by a caller supplied timeout, leaving it untouched when the timeout is not positive
+func withTimeout(ctx context.Context, timeoutSeconds int64) (context.Context, context.CancelFunc) {
+	if timeoutSeconds <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+}
+
+func jobOutcome(job *batchv1.Job) pb.JobOutcome {
+	switch condition, _ := manager.JobFinished(job); condition {
+	case batchv1.JobComplete:
+		return pb.JobOutcome_COMPLETE
+	case batchv1.JobFailed:
+		return pb.JobOutcome_FAILED
+	default:
+		return pb.JobOutcome_UNFINISHED
+	}
 }
 
 func (s *K8sService) DeleteJob(ctx context.Context, in *pb.DeleteJobRequest) (*pb.DeleteJobResponse, error) {
-	err := s.manager.DeleteJob(ctx, in.Name)
+	err := s.manager.DeleteJob(ctx, in.Name, in.DryRun)
 	return &pb.DeleteJobResponse{}, err
 }
@@ -2,10 +2,23 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"github.com/Tlantic/k8s-sidecar/internal/manager"
 	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestNewK8sService(t *testing.T) {
@@ -33,4 +46,285 @@ func TestNewK8sService(t *testing.T) {
 		}
 		t.Log(res)
 	})
+
+	t.Run("GetConfigMapByName", func(t *testing.T) {
+		res, err := service.GetConfigMap(context.Background(), &pb.GetConfigMapRequest{
+			Name: "mrs-service-scheduler",
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		if res.GetConfigMap().GetName() != "mrs-service-scheduler" {
+			t.Errorf("Unexpected config map %v", res.GetConfigMap())
+		}
+		t.Log(res)
+	})
+}
+
+func TestWatchedConfigMaps(t *testing.T) {
+	data := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings"},
+		Data:       map[string]string{"settings": "legacy", "app.yaml": "entry"},
+	}
+
+	names, entry, err := watchedConfigMaps(&pb.WatchConfigMapRequest{Keys: []string{"settings"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "settings" || entry(data) != "legacy" {
+		t.Errorf("unexpected legacy watch %v %q", names, entry(data))
+	}
+
+	names, entry, err = watchedConfigMaps(&pb.WatchConfigMapRequest{Names: []string{"settings"}, Key: "app.yaml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "settings" || entry(data) != "entry" {
+		t.Errorf("unexpected watch %v %q", names, entry(data))
+	}
+
+	_, entry, err = watchedConfigMaps(&pb.WatchConfigMapRequest{Names: []string{"settings"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry(data) != "" {
+		t.Errorf("expected no config without a key, got %q", entry(data))
+	}
+
+	for _, in := range []*pb.WatchConfigMapRequest{
+		{Keys: []string{"settings"}, Names: []string{"settings"}},
+		{Keys: []string{"settings"}, Key: "app.yaml"},
+	} {
+		if _, _, err := watchedConfigMaps(in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for %v, got %v", in, err)
+		}
+	}
+}
+
+func TestOverrideConcurrencyPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		template batchv1.ConcurrencyPolicy
+		override string
+		expected batchv1.ConcurrencyPolicy
+		code     codes.Code
+	}{
+		{"TemplateKept", batchv1.AllowConcurrent, "", batchv1.AllowConcurrent, codes.OK},
+		{"EmptyKept", "", "", "", codes.OK},
+		{"OverrideWins", batchv1.AllowConcurrent, "Forbid", batchv1.ForbidConcurrent, codes.OK},
+		{"InvalidRejected", batchv1.AllowConcurrent, "Never", batchv1.AllowConcurrent, codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			template := &batchv1.CronJob{Spec: batchv1.CronJobSpec{ConcurrencyPolicy: test.template}}
+			err := overrideConcurrencyPolicy([]*batchv1.CronJob{template}, test.override)
+			if status.Code(err) != test.code {
+				t.Errorf("expected %v, got %v", test.code, err)
+			}
+			if template.Spec.ConcurrencyPolicy != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, template.Spec.ConcurrencyPolicy)
+			}
+		})
+	}
+}
+
+func TestNewManualJob(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 60), UID: "1234"},
+		Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "mrs"}},
+				Spec: batchv1.JobSpec{
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "main", Args: []string{"--all"}, Env: []v1.EnvVar{{Name: "MODE", Value: "full"}}},
+								{Name: "proxy"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	job := newManualJob(cronJob)
+	if len(job.Name) != 63 || !strings.HasPrefix(job.Name, strings.Repeat("a", 50)+"-manual-") {
+		t.Errorf("unexpected job name %q", job.Name)
+	}
+	if other := newManualJob(cronJob); other.Name == job.Name {
+		t.Errorf("expected distinct names, got %q twice", job.Name)
+	}
+	if job.Annotations[manualInstantiateAnnotation] != "manual" || job.Labels["team"] != "mrs" {
+		t.Errorf("unexpected metadata %v %v", job.Annotations, job.Labels)
+	}
+	if owner := metav1.GetControllerOf(job); owner == nil || owner.Kind != "CronJob" || owner.UID != "1234" {
+		t.Errorf("unexpected owner %v", owner)
+	}
+
+	if err := overrideContainers(&job.Spec.Template.Spec, "main", map[string]string{"MODE": "delta", "VERBOSE": "1", "DEBUG": "1"}, []string{"--since=1h"}); err != nil {
+		t.Fatal(err)
+	}
+	main := job.Spec.Template.Spec.Containers[0]
+	if len(main.Env) != 3 || main.Env[0].Value != "delta" || main.Env[1].Name != "DEBUG" || main.Env[2].Name != "VERBOSE" || main.Args[0] != "--since=1h" {
+		t.Errorf("unexpected overrides %v %v", main.Env, main.Args)
+	}
+	if len(job.Spec.Template.Spec.Containers[1].Env) != 0 {
+		t.Errorf("expected other containers untouched")
+	}
+	if cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args[0] != "--all" {
+		t.Errorf("expected cron job template untouched")
+	}
+	if err := overrideContainers(&job.Spec.Template.Spec, "missing", nil, nil); err == nil {
+		t.Errorf("expected error for missing container")
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	now := time.Unix(1617271200, 0)
+	newJob := func(name, suffix string) *batchv1.Job {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if suffix != "" {
+			job.Annotations = map[string]string{nameSuffixAnnotation: suffix}
+		}
+		return job
+	}
+
+	job := newJob("import", "")
+	if err := uniqueName(job, now); err != nil || job.Name != "import" {
+		t.Errorf("expected name untouched, got %q %v", job.Name, err)
+	}
+
+	job = newJob(strings.Repeat("a", 60), "timestamp")
+	if err := uniqueName(job, now); err != nil || len(job.Name) != 63 || !strings.HasSuffix(job.Name, "-1617271200") {
+		t.Errorf("unexpected timestamp name %q %v", job.Name, err)
+	}
+
+	job = newJob("import", "random")
+	if err := uniqueName(job, now); err != nil || !strings.HasPrefix(job.Name, "import-") || len(job.Name) != len("import-")+5 {
+		t.Errorf("unexpected random name %q %v", job.Name, err)
+	}
+
+	for _, job := range []*batchv1.Job{newJob("import", "uuid"), newJob("", "random")} {
+		if err := uniqueName(job, now); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for %v, got %v", job.ObjectMeta, err)
+		}
+	}
+}
+
+func TestUpdateCronJobInvalidPatch(t *testing.T) {
+	// invalid patches are rejected before the API server is called
+	service := NewK8sService(&manager.KubeManager{})
+	for _, template := range []string{"", "null", "- schedule", "metadata: x"} {
+		_, err := service.UpdateCronJob(context.Background(), &pb.UpdateCronJobRequest{
+			Name:     "nightly",
+			Template: template,
+			Strategy: pb.UpdateStrategy_STRATEGIC_MERGE_PATCH,
+		})
+		if code := status.Code(toStatusError(err)); code != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for %q, got %v", template, err)
+		}
+	}
+}
+
+func TestCronJobDiff(t *testing.T) {
+	before := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "report", ResourceVersion: "1", Labels: map[string]string{"team": "mrs"}},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 3 * * *"},
+	}
+	after := before.DeepCopy()
+	after.ResourceVersion = "2"
+	after.Spec.Schedule = "0 4 * * *"
+
+	diff, err := cronJobDiff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"spec":{"schedule":"0 4 * * *"}}`; diff != expected {
+		t.Errorf("expected %s, got %s", expected, diff)
+	}
+
+	if diff, _ := cronJobDiff(before, before); diff != "{}" {
+		t.Errorf("expected empty diff, got %s", diff)
+	}
+}
+
+// watchJobStream collects the events of a WatchJob call
+type watchJobStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*pb.WatchJobResponse
+}
+
+func (s *watchJobStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchJobStream) Send(event *pb.WatchJobResponse) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestWatchJobNotCached(t *testing.T) {
+	const jobsPath = "/apis/batch/v1/namespaces/ns/jobs"
+	job := func(resourceVersion int, status string) string {
+		return fmt.Sprintf(`{"kind":"Job","apiVersion":"batch/v1","metadata":{"name":"report","namespace":"ns","resourceVersion":"%d"},"status":{%s}}`, resourceVersion, status)
+	}
+	// the Job was just created: it can be read but the informer cache has yet to see it, the watch
+	// delivers it once WatchJob has read it, then it completes
+	read := make(chan struct{})
+	var readOnce sync.Once
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == jobsPath+"/report":
+			fmt.Fprint(w, job(2, ""))
+			readOnce.Do(func() { close(read) })
+		case r.URL.Path == jobsPath && r.URL.Query().Get("watch") != "true":
+			fmt.Fprint(w, `{"kind":"JobList","apiVersion":"batch/v1","metadata":{"resourceVersion":"1"},"items":[]}`)
+		case r.URL.Path == jobsPath:
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			select {
+			case <-read:
+			case <-r.Context().Done():
+				return
+			}
+			fmt.Fprintf(w, `{"type":"ADDED","object":%s}`+"\n", job(2, ""))
+			fmt.Fprintf(w, `{"type":"MODIFIED","object":%s}`+"\n", job(3, `"conditions":[{"type":"Complete","status":"True"}]`))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer apiServer.Close()
+
+	kubeConfig := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(kubeConfig, []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters: [{name: test, cluster: {server: %q}}]
+contexts: [{name: test, context: {cluster: test, namespace: ns}}]
+current-context: test
+`, apiServer.URL)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kubeManager, err := manager.NewKube(&manager.KubeManagerOptions{Config: kubeConfig, Namespace: "ns"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kubeManager.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := &watchJobStream{ctx: ctx}
+	if err := NewK8sService(kubeManager).WatchJob(&pb.WatchJobRequest{Name: "report"}, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	// the ADDED event has the same empty status as the zero value and must still be sent
+	if len(stream.events) != 2 || stream.events[0].Type != pb.WatchEventType_ADDED || stream.events[1].Outcome != pb.JobOutcome_COMPLETE {
+		t.Errorf("expected ADDED then the completion, got %v", stream.events)
+	}
 }
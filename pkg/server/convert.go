@@ -0,0 +1,166 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"strings"
+)
+
+func toConfigMap(configMap *v1.ConfigMap) *pb.ConfigMap {
+	return &pb.ConfigMap{
+		Name:            configMap.Name,
+		Data:            configMap.Data,
+		BinaryData:      configMap.BinaryData,
+		ResourceVersion: configMap.ResourceVersion,
+		Labels:          configMap.Labels,
+		Annotations:     configMap.Annotations,
+	}
+}
+
+func fromConfigMap(configMap *pb.ConfigMap) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            configMap.Name,
+			ResourceVersion: configMap.ResourceVersion,
+			Labels:          configMap.Labels,
+			Annotations:     configMap.Annotations,
+		},
+		Data:       configMap.Data,
+		BinaryData: configMap.BinaryData,
+	}
+}
+
+func toSecret(secret *v1.Secret) *pb.Secret {
+	return &pb.Secret{
+		Name:            secret.Name,
+		Type:            string(secret.Type),
+		Data:            secret.Data,
+		ResourceVersion: secret.ResourceVersion,
+		Labels:          secret.Labels,
+		Annotations:     secret.Annotations,
+	}
+}
+
+func toIdentity(object metav1.Object) *pb.ObjectIdentity {
+	creationTimestamp := object.GetCreationTimestamp()
+	return &pb.ObjectIdentity{
+		Name:              object.GetName(),
+		UID:               string(object.GetUID()),
+		ResourceVersion:   object.GetResourceVersion(),
+		CreationTimestamp: toTimestamp(&creationTimestamp),
+	}
+}
+
+func toIdentities[T metav1.Object](objects []T) []*pb.ObjectIdentity {
+	identities := make([]*pb.ObjectIdentity, len(objects))
+	for index, object := range objects {
+		identities[index] = toIdentity(object)
+	}
+	return identities
+}
+
+func toCronJob(cronJob *batchv1.CronJob) *pb.CronJob {
+	activeJobs := make([]string, len(cronJob.Status.Active))
+	for index, ref := range cronJob.Status.Active {
+		activeJobs[index] = ref.Name
+	}
+
+	containers := cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers
+	images := make([]string, len(containers))
+	for index, container := range containers {
+		images[index] = container.Image
+	}
+
+	return &pb.CronJob{
+		Name:               cronJob.Name,
+		Schedule:           cronJob.Spec.Schedule,
+		TimeZone:           scheduleTimeZone(cronJob.Spec.Schedule),
+		Suspend:            cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		ConcurrencyPolicy:  string(cronJob.Spec.ConcurrencyPolicy),
+		LastScheduleTime:   toTimestamp(cronJob.Status.LastScheduleTime),
+		LastSuccessfulTime: toTimestamp(cronJob.Status.LastSuccessfulTime),
+		ActiveJobs:         activeJobs,
+		Labels:             cronJob.Labels,
+		Annotations:        cronJob.Annotations,
+		Images:             images,
+	}
+}
+
+func toJob(job *batchv1.Job) *pb.Job {
+	conditions := make([]*pb.JobCondition, len(job.Status.Conditions))
+	for index, condition := range job.Status.Conditions {
+		conditions[index] = &pb.JobCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: toTimestamp(&condition.LastTransitionTime),
+		}
+	}
+
+	var cronJob string
+	if owner := metav1.GetControllerOf(job); owner != nil && owner.Kind == "CronJob" {
+		cronJob = owner.Name
+	}
+
+	return &pb.Job{
+		Name:           job.Name,
+		Active:         job.Status.Active,
+		Succeeded:      job.Status.Succeeded,
+		Failed:         job.Status.Failed,
+		StartTime:      toTimestamp(job.Status.StartTime),
+		CompletionTime: toTimestamp(job.Status.CompletionTime),
+		Conditions:     conditions,
+		CronJob:        cronJob,
+	}
+}
+
+// objectJSON encodes an API object with its apiVersion and kind, which the client leaves empty
+func objectJSON(object runtime.Object) (string, error) {
+	object = object.DeepCopyObject()
+	kinds, _, err := scheme.Scheme.ObjectKinds(object)
+	if err != nil {
+		return "", err
+	}
+	object.GetObjectKind().SetGroupVersionKind(kinds[0])
+
+	data, err := json.Marshal(object)
+	return string(data), err
+}
+
+func objectsJSON[T runtime.Object](objects []T) ([]string, error) {
+	encoded := make([]string, len(objects))
+	for index, object := range objects {
+		var err error
+		if encoded[index], err = objectJSON(object); err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+// scheduleTimeZone extracts the zone of a "CRON_TZ=<zone> <spec>" or "TZ=<zone> <spec>" schedule
+func scheduleTimeZone(schedule string) string {
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if !strings.HasPrefix(schedule, prefix) {
+			continue
+		}
+		if fields := strings.Fields(strings.TrimPrefix(schedule, prefix)); len(fields) > 0 {
+			return fields[0]
+		}
+	}
+	return ""
+}
+
+func toTimestamp(t *metav1.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToCronJob(t *testing.T) {
+	suspend := true
+	lastSchedule := metav1.NewTime(time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC))
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "report", Labels: map[string]string{"team": "mrs"}},
+		Spec: batchv1.CronJobSpec{
+			Schedule:          "CRON_TZ=Europe/Lisbon 0 3 * * *",
+			Suspend:           &suspend,
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{{Name: "report", Image: "tlantic/report:1.2"}},
+						},
+					},
+				},
+			},
+		},
+		Status: batchv1.CronJobStatus{
+			Active:           []v1.ObjectReference{{Name: "report-1617271200"}},
+			LastScheduleTime: &lastSchedule,
+		},
+	}
+
+	res := toCronJob(cronJob)
+	if res.TimeZone != "Europe/Lisbon" {
+		t.Errorf("unexpected time zone %q", res.TimeZone)
+	}
+	if !res.Suspend || res.ConcurrencyPolicy != "Forbid" {
+		t.Errorf("unexpected spec mapping %v", res)
+	}
+	if len(res.ActiveJobs) != 1 || res.ActiveJobs[0] != "report-1617271200" {
+		t.Errorf("unexpected active jobs %v", res.ActiveJobs)
+	}
+	if len(res.Images) != 1 || res.Images[0] != "tlantic/report:1.2" {
+		t.Errorf("unexpected images %v", res.Images)
+	}
+	if !res.LastScheduleTime.AsTime().Equal(lastSchedule.Time) || res.LastSuccessfulTime != nil {
+		t.Errorf("unexpected times %v %v", res.LastScheduleTime, res.LastSuccessfulTime)
+	}
+}
+
+func TestToJob(t *testing.T) {
+	controller := true
+	start := metav1.NewTime(time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC))
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "report-1617271200",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "CronJob", Name: "report", Controller: &controller},
+			},
+		},
+		Status: batchv1.JobStatus{
+			Failed:    2,
+			StartTime: &start,
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+			},
+		},
+	}
+
+	res := toJob(job)
+	if res.CronJob != "report" {
+		t.Errorf("unexpected owner %q", res.CronJob)
+	}
+	if res.Failed != 2 || res.Active != 0 || res.Succeeded != 0 {
+		t.Errorf("unexpected counts %v", res)
+	}
+	if res.CompletionTime != nil || !res.StartTime.AsTime().Equal(start.Time) {
+		t.Errorf("unexpected times %v %v", res.StartTime, res.CompletionTime)
+	}
+	if len(res.Conditions) != 1 || res.Conditions[0].Type != "Failed" || res.Conditions[0].Reason != "BackoffLimitExceeded" {
+		t.Errorf("unexpected conditions %v", res.Conditions)
+	}
+	if res.Conditions[0].LastTransitionTime != nil {
+		t.Errorf("expected zero transition time to be omitted")
+	}
+}
+
+func TestScheduleTimeZone(t *testing.T) {
+	for schedule, expected := range map[string]string{
+		"0 3 * * *":                       "",
+		"TZ=UTC 0 3 * * *":                "UTC",
+		"CRON_TZ=America/New_York @daily": "America/New_York",
+		"CRON_TZ=":                        "",
+	} {
+		if zone := scheduleTimeZone(schedule); zone != expected {
+			t.Errorf("%q: expected %q, got %q", schedule, expected, zone)
+		}
+	}
+}
+
+func TestObjectJSON(t *testing.T) {
+	encoded, err := objectsJSON([]*batchv1.Job{{ObjectMeta: metav1.ObjectMeta{Name: "import"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(encoded) != 1 || !strings.HasPrefix(encoded[0], `{"kind":"Job","apiVersion":"batch/v1","metadata":{"name":"import"`) {
+		t.Errorf("unexpected encoding %v", encoded)
+	}
+}
+
+func TestToIdentity(t *testing.T) {
+	created := metav1.NewTime(time.Date(2021, 5, 4, 3, 0, 0, 0, time.UTC))
+	identities := toIdentities([]*batchv1.Job{{ObjectMeta: metav1.ObjectMeta{
+		Name:              "import-x7k2p",
+		UID:               "8a1f",
+		ResourceVersion:   "1042",
+		CreationTimestamp: created,
+	}}})
+	if len(identities) != 1 {
+		t.Fatalf("expected one identity, got %d", len(identities))
+	}
+	res := identities[0]
+	if res.Name != "import-x7k2p" || res.UID != "8a1f" || res.ResourceVersion != "1042" {
+		t.Errorf("unexpected identity %v", res)
+	}
+	if !res.CreationTimestamp.AsTime().Equal(created.Time) {
+		t.Errorf("unexpected creation timestamp %v", res.CreationTimestamp)
+	}
+}
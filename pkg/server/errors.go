@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"net/http"
+	"time"
+)
+
+// errorDomain is the ErrorInfo domain of errors reported by the Kubernetes API server
+const errorDomain = "k8s.io"
+
+// reasonCodes maps the reasons of Kubernetes API errors to gRPC codes
+var reasonCodes = map[metav1.StatusReason]codes.Code{
+	metav1.StatusReasonUnauthorized:          codes.Unauthenticated,
+	metav1.StatusReasonForbidden:             codes.PermissionDenied,
+	metav1.StatusReasonNotFound:              codes.NotFound,
+	metav1.StatusReasonAlreadyExists:         codes.AlreadyExists,
+	metav1.StatusReasonConflict:              codes.Aborted,
+	metav1.StatusReasonGone:                  codes.Aborted,
+	metav1.StatusReasonExpired:               codes.Aborted,
+	metav1.StatusReasonInvalid:               codes.InvalidArgument,
+	metav1.StatusReasonBadRequest:            codes.InvalidArgument,
+	metav1.StatusReasonNotAcceptable:         codes.InvalidArgument,
+	metav1.StatusReasonUnsupportedMediaType:  codes.InvalidArgument,
+	metav1.StatusReasonMethodNotAllowed:      codes.Unimplemented,
+	metav1.StatusReasonServerTimeout:         codes.Unavailable,
+	metav1.StatusReasonServiceUnavailable:    codes.Unavailable,
+	metav1.StatusReasonTimeout:               codes.DeadlineExceeded,
+	metav1.StatusReasonTooManyRequests:       codes.ResourceExhausted,
+	metav1.StatusReasonRequestEntityTooLarge: codes.ResourceExhausted,
+	metav1.StatusReasonInternalError:         codes.Internal,
+}
+
+// httpCodes maps the HTTP status of Kubernetes API errors without a known reason to gRPC codes
+var httpCodes = map[int32]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.Aborted,
+	http.StatusGone:                codes.Aborted,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusInternalServerError: codes.Internal,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
+
+// UnaryErrorInterceptor translates the errors returned by unary handlers with toStatusError
+func UnaryErrorInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+// StreamErrorInterceptor translates the errors returned by stream handlers with toStatusError
+func StreamErrorInterceptor(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatusError(handler(srv, stream))
+}
+
+// toStatusError converts Kubernetes API and context errors to gRPC status errors.
+// API errors carry ErrorInfo and ResourceInfo details naming the object, BadRequest details
+// listing their causes and RetryInfo when the API server suggests a delay.
+// Status errors and any other error are returned unchanged.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return err
+	}
+	apiErr := apiStatus.Status()
+
+	code, ok := reasonCodes[apiErr.Reason]
+	if !ok {
+		if code, ok = httpCodes[apiErr.Code]; !ok {
+			code = codes.Unknown
+		}
+	}
+	st := status.New(code, err.Error())
+
+	details := []proto.Message{errorInfo(apiErr)}
+	if apiErr.Details != nil {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: apiErr.Details.Kind,
+			ResourceName: apiErr.Details.Name,
+			Description:  apiErr.Message,
+		})
+		if len(apiErr.Details.Causes) > 0 {
+			badRequest := &errdetails.BadRequest{}
+			for _, cause := range apiErr.Details.Causes {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       cause.Field,
+					Description: cause.Message,
+				})
+			}
+			details = append(details, badRequest)
+		}
+		if apiErr.Details.RetryAfterSeconds > 0 {
+			details = append(details, &errdetails.RetryInfo{
+				RetryDelay: durationpb.New(time.Duration(apiErr.Details.RetryAfterSeconds) * time.Second),
+			})
+		}
+	}
+
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+func errorInfo(apiErr metav1.Status) *errdetails.ErrorInfo {
+	info := &errdetails.ErrorInfo{
+		Reason: string(apiErr.Reason),
+		Domain: errorDomain,
+	}
+	if apiErr.Details != nil {
+		info.Metadata = map[string]string{
+			"group": apiErr.Details.Group,
+			"kind":  apiErr.Details.Kind,
+			"name":  apiErr.Details.Name,
+		}
+	}
+	return info
+}
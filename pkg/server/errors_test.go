@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"testing"
+)
+
+func TestToStatusError(t *testing.T) {
+	jobs := batchv1.Resource("jobs")
+
+	for name, test := range map[string]struct {
+		err  error
+		code codes.Code
+	}{
+		"NotFound":      {apierrors.NewNotFound(jobs, "import"), codes.NotFound},
+		"AlreadyExists": {apierrors.NewAlreadyExists(jobs, "import"), codes.AlreadyExists},
+		"Conflict":      {apierrors.NewConflict(jobs, "import", errors.New("modified")), codes.Aborted},
+		"Forbidden":     {apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "db", errors.New("not allowed")), codes.PermissionDenied},
+		"Timeout":       {apierrors.NewTimeoutError("slow", 1), codes.DeadlineExceeded},
+		"Wrapped":       {fmt.Errorf("get: %w", apierrors.NewNotFound(jobs, "import")), codes.NotFound},
+		"HTTPCode":      {apierrors.NewGenericServerResponse(503, "get", jobs, "import", "", 0, false), codes.Unavailable},
+		"Deadline":      {context.DeadlineExceeded, codes.DeadlineExceeded},
+		"Canceled":      {context.Canceled, codes.Canceled},
+		"Status":        {status.Error(codes.FailedPrecondition, "no template"), codes.FailedPrecondition},
+		"Other":         {errors.New("boom"), codes.Unknown},
+	} {
+		if code := status.Code(toStatusError(test.err)); code != test.code {
+			t.Errorf("%s: expected %s, got %s", name, test.code, code)
+		}
+	}
+
+	if toStatusError(nil) != nil {
+		t.Errorf("expected nil error")
+	}
+}
+
+func TestToStatusErrorDetails(t *testing.T) {
+	err := apierrors.NewInvalid(batchv1.SchemeGroupVersion.WithKind("Job").GroupKind(), "import", field.ErrorList{
+		field.Required(field.NewPath("spec", "template"), "template is required"),
+	})
+
+	st := status.Convert(toStatusError(err))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("unexpected code %s", st.Code())
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = detail
+		case *errdetails.BadRequest:
+			badRequest = detail
+		}
+	}
+	if info == nil || info.Reason != string(metav1.StatusReasonInvalid) || info.Metadata["kind"] != "Job" || info.Metadata["name"] != "import" {
+		t.Errorf("unexpected error info %v", info)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "spec.template" {
+		t.Errorf("unexpected bad request %v", badRequest)
+	}
+}
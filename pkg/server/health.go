@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"github.com/Tlantic/k8s-sidecar/internal/manager"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"log"
+	"time"
+)
+
+// HealthServiceName is the service name K8sService reports its health under
+const HealthServiceName = "pb.K8sService"
+
+// MonitorHealth checks every interval whether manager reaches the API server with synced informers and
+// reports the result as the status of HealthServiceName until ctx is done. The overall server status
+// is left SERVING so liveness probes do not restart the sidecar while the API server is unreachable.
+func MonitorHealth(ctx context.Context, manager *manager.KubeManager, healthServer *health.Server, interval time.Duration) {
+	healthServer.SetServingStatus(HealthServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	var lastErr error
+	first := true
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		err := manager.CheckHealth(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus(HealthServiceName, servingStatus)
+		if err != nil && (first || lastErr == nil) {
+			log.Printf("%s is %s: %v", HealthServiceName, servingStatus, err)
+		} else if err == nil && (first || lastErr != nil) {
+			log.Printf("%s is %s", HealthServiceName, servingStatus)
+		}
+		first, lastErr = false, err
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"log"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	// templateKey holds the Go text/template rendering the Job YAML or JSON
+	templateKey = "template"
+	// parametersKey holds the YAML list of parameters the template accepts
+	parametersKey = "parameters"
+)
+
+// templateFuncs are available to library templates on top of the text/template builtins
+var templateFuncs = template.FuncMap{
+	"quote": strconv.Quote,
+	"split": strings.Split,
+	"toJson": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+}
+
+func (s *K8sService) ListJobTemplates(ctx context.Context, _ *pb.ListJobTemplatesRequest) (*pb.ListJobTemplatesResponse, error) {
+	list, err := s.manager.ListJobTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*pb.JobTemplate, 0, len(list))
+	for index := range list {
+		parsed, err := parseJobTemplate(&list[index])
+		if err != nil {
+			log.Printf("skipping job template: %v", err)
+			continue
+		}
+		templates = append(templates, parsed.toProto())
+	}
+
+	return &pb.ListJobTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+func (s *K8sService) CreateJobFromTemplate(ctx context.Context, in *pb.CreateJobFromTemplateRequest) (*pb.CreateJobResponse, error) {
+	cfgMap, err := s.manager.GetJobTemplate(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseJobTemplate(cfgMap)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := parsed.render(in.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := decodeJobs(rendered, pb.TemplateFormat_AUTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createJobs(ctx, jobs, in.IdempotencyKey, in.Wait, in.WaitTimeoutSeconds, in.DryRun)
+}
+
+// templateParameter declares a value a library template accepts
+type templateParameter struct {
+	Name        string `json:"name"`
+	Required    bool   `json:"required,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// jobTemplate is a Job template registered in a labelled ConfigMap
+type jobTemplate struct {
+	name       string
+	body       *template.Template
+	parameters []templateParameter
+}
+
+// parseJobTemplate reads the template and parameter declarations of a library ConfigMap
+func parseJobTemplate(cfgMap *v1.ConfigMap) (*jobTemplate, error) {
+	body, ok := cfgMap.Data[templateKey]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "template %s has no %q key", cfgMap.Name, templateKey)
+	}
+
+	parsed, err := template.New(cfgMap.Name).Funcs(templateFuncs).Option("missingkey=error").Parse(body)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "template %s: %v", cfgMap.Name, err)
+	}
+
+	var parameters []templateParameter
+	if err := yaml.Unmarshal([]byte(cfgMap.Data[parametersKey]), &parameters); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "template %s parameters: %v", cfgMap.Name, err)
+	}
+
+	return &jobTemplate{
+		name:       cfgMap.Name,
+		body:       parsed,
+		parameters: parameters,
+	}, nil
+}
+
+func (t *jobTemplate) toProto() *pb.JobTemplate {
+	parameters := make([]*pb.TemplateParameter, len(t.parameters))
+	for index, parameter := range t.parameters {
+		parameters[index] = &pb.TemplateParameter{
+			Name:        parameter.Name,
+			Required:    parameter.Required,
+			Default:     parameter.Default,
+			Description: parameter.Description,
+		}
+	}
+	return &pb.JobTemplate{
+		Name:       t.name,
+		Parameters: parameters,
+	}
+}
+
+// render fills the template with params over the declared defaults, rejecting missing required
+// parameters and parameters the template does not declare
+func (t *jobTemplate) render(params map[string]string) (string, error) {
+	values := make(map[string]string, len(t.parameters))
+	declared := make(map[string]struct{}, len(t.parameters))
+	var missing []string
+	for _, parameter := range t.parameters {
+		declared[parameter.Name] = struct{}{}
+		value, ok := params[parameter.Name]
+		if !ok {
+			value = parameter.Default
+		}
+		if parameter.Required && value == "" {
+			missing = append(missing, parameter.Name)
+		}
+		values[parameter.Name] = value
+	}
+	if len(missing) > 0 {
+		return "", status.Errorf(codes.InvalidArgument, "template %s: missing required parameters %s", t.name, strings.Join(missing, ", "))
+	}
+
+	var unknown []string
+	for name := range params {
+		if _, ok := declared[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", status.Errorf(codes.InvalidArgument, "template %s: unknown parameters %s", t.name, strings.Join(unknown, ", "))
+	}
+
+	var out bytes.Buffer
+	if err := t.body.Execute(&out, values); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "template %s: %v", t.name, err)
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+func TestJobTemplate(t *testing.T) {
+	parsed, err := parseJobTemplate(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "import"},
+		Data: map[string]string{
+			templateKey: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: import-{{ .Run }}
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: import
+        image: tlantic/import:{{ .ImageTag }}
+        args: {{ split .Args "," | toJson }}
+        env:
+        - name: LOG_LEVEL
+          value: {{ quote .LogLevel }}
+`,
+			parametersKey: `
+- name: Run
+  required: true
+- name: ImageTag
+  required: true
+- name: Args
+  default: --all
+- name: LogLevel
+  default: info
+`,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Render", func(t *testing.T) {
+		rendered, err := parsed.render(map[string]string{"Run": "42", "ImageTag": "1.2", "Args": "--from,2021-04-01"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		jobs, err := decodeJobs(rendered, pb.TemplateFormat_AUTO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		container := jobs[0].Spec.Template.Spec.Containers[0]
+		if jobs[0].Name != "import-42" || container.Image != "tlantic/import:1.2" {
+			t.Errorf("unexpected job %s %s", jobs[0].Name, container.Image)
+		}
+		if len(container.Args) != 2 || container.Args[1] != "2021-04-01" || container.Env[0].Value != "info" {
+			t.Errorf("unexpected container %v %v", container.Args, container.Env)
+		}
+	})
+
+	t.Run("MissingRequired", func(t *testing.T) {
+		_, err := parsed.render(map[string]string{"Run": "42"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("UnknownParameter", func(t *testing.T) {
+		_, err := parsed.render(map[string]string{"Run": "42", "ImageTag": "1.2", "Image": "other"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	if proto := parsed.toProto(); len(proto.Parameters) != 4 || !proto.Parameters[0].Required || proto.Parameters[2].Default != "--all" {
+		t.Errorf("unexpected parameters %v", proto.Parameters)
+	}
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"github.com/Tlantic/k8s-sidecar/internal/manager"
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/tools/metrics"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	grpcStarted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_started_total",
+		Help: "Total number of RPCs started on the server.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+	grpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Total number of RPCs completed on the server, regardless of success or failure.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method", "grpc_code"})
+	grpcHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Histogram of response latency (seconds) of RPCs handled by the server.",
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+
+	restRequestSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "rest_client_request_duration_seconds",
+		Help:    "Request latency in seconds of the Kubernetes client, by verb and URL.",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 10),
+	}, []string{"verb", "url"})
+	restRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rest_client_requests_total",
+		Help: "Number of Kubernetes client requests, by status code, method and host.",
+	}, []string{"code", "method", "host"})
+
+	jobOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "k8s_sidecar_job_outcomes_total",
+		Help: "Outcomes of the Jobs the sidecar waited for, UNFINISHED when the wait timed out.",
+	}, []string{"outcome"})
+
+	informerObjectsDesc = prometheus.NewDesc(
+		"k8s_sidecar_informer_objects",
+		"Number of objects in the cache of a shared informer.",
+		[]string{"resource"}, nil,
+	)
+	watchSubscribersDesc = prometheus.NewDesc(
+		"k8s_sidecar_watch_subscribers",
+		"Number of watch streams subscribed to a shared informer.",
+		[]string{"resource"}, nil,
+	)
+)
+
+// RegisterMetrics registers the gRPC, Kubernetes client, informer and job metrics with registerer
+// and hooks the Kubernetes client metrics into client-go, which only accepts them once per process
+func RegisterMetrics(registerer prometheus.Registerer, manager *manager.KubeManager) error {
+	for _, collector := range []prometheus.Collector{
+		grpcStarted, grpcHandled, grpcHandlingSeconds,
+		restRequestSeconds, restRequests,
+		jobOutcomes,
+		informerCollector{manager},
+	} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	metrics.Register(metrics.RegisterOpts{
+		RequestLatency: requestLatency{},
+		RequestResult:  requestResult{},
+	})
+	return nil
+}
+
+// UnaryMetricsInterceptor counts unary calls and observes their latency by method and code
+func UnaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	observe := observeCall("unary", info.FullMethod)
+	resp, err := handler(ctx, req)
+	observe(err)
+	return resp, err
+}
+
+// StreamMetricsInterceptor counts stream calls and observes their duration by method and code
+func StreamMetricsInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	observe := observeCall(streamType(info), info.FullMethod)
+	err := handler(srv, stream)
+	observe(err)
+	return err
+}
+
+// observeCall counts a started call and returns the func recording its completion
+func observeCall(callType, fullMethod string) func(error) {
+	service, method := splitMethod(fullMethod)
+	grpcStarted.WithLabelValues(callType, service, method).Inc()
+	start := time.Now()
+	return func(err error) {
+		grpcHandled.WithLabelValues(callType, service, method, status.Code(err).String()).Inc()
+		grpcHandlingSeconds.WithLabelValues(callType, service, method).Observe(time.Since(start).Seconds())
+	}
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return "bidi_stream"
+	case info.IsClientStream:
+		return "client_stream"
+	default:
+		return "server_stream"
+	}
+}
+
+// splitMethod splits "/pb.K8sService/GetJob" into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if index := strings.LastIndex(fullMethod, "/"); index >= 0 {
+		return fullMethod[:index], fullMethod[index+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// countJobOutcome records the outcome of a wait for a job
+func countJobOutcome(outcome pb.JobOutcome) {
+	jobOutcomes.WithLabelValues(outcome.String()).Inc()
+}
+
+// informerCollector reports the cache sizes and subscriber counts of the started shared informers
+type informerCollector struct {
+	manager *manager.KubeManager
+}
+
+func (c informerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- informerObjectsDesc
+	ch <- watchSubscribersDesc
+}
+
+func (c informerCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range c.manager.InformerStats() {
+		ch <- prometheus.MustNewConstMetric(informerObjectsDesc, prometheus.GaugeValue, float64(stats.Objects), stats.Resource)
+		ch <- prometheus.MustNewConstMetric(watchSubscribersDesc, prometheus.GaugeValue, float64(stats.Subscribers), stats.Resource)
+	}
+}
+
+// requestLatency adapts restRequestSeconds to client-go
+type requestLatency struct{}
+
+func (requestLatency) Observe(_ context.Context, verb string, u url.URL, latency time.Duration) {
+	restRequestSeconds.WithLabelValues(verb, u.String()).Observe(latency.Seconds())
+}
+
+// requestResult adapts restRequests to client-go
+type requestResult struct{}
+
+func (requestResult) Increment(_ context.Context, code, method, host string) {
+	restRequests.WithLabelValues(code, method, host).Inc()
+}
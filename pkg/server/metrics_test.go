@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestSplitMethod(t *testing.T) {
+	if service, method := splitMethod("/pb.K8sService/GetJob"); service != "pb.K8sService" || method != "GetJob" {
+		t.Errorf("unexpected split %q %q", service, method)
+	}
+}
+
+func TestUnaryMetricsInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.K8sService/GetJob"}
+	handled := grpcHandled.WithLabelValues("unary", "pb.K8sService", "GetJob", codes.NotFound.String())
+	before := testutil.ToFloat64(handled)
+
+	// the error interceptor runs inside so the NotFound code is recorded
+	_, err := UnaryMetricsInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return UnaryErrorInterceptor(ctx, req, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "missing")
+		})
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+	if after := testutil.ToFloat64(handled); after != before+1 {
+		t.Errorf("expected one handled call, got %v", after-before)
+	}
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	v1 "k8s.io/api/core/v1"
+	"log"
+	"sort"
+	"strings"
+)
+
+func (s *K8sService) GetSecret(ctx context.Context, in *pb.GetSecretRequest) (*pb.GetSecretResponse, error) {
+	secret, err := s.manager.GetSecret(ctx, in.Name)
+	if err != nil {
+		log.Printf("get secret %s: %v", in.Name, err)
+		return nil, err
+	}
+
+	log.Printf("serving secret %s", redactSecret(secret))
+	return &pb.GetSecretResponse{
+		Secret: toSecret(secret),
+	}, nil
+}
+
+func (s *K8sService) ListSecrets(ctx context.Context, _ *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
+	list, err := s.manager.ListSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]*pb.Secret, len(list))
+	for index := range list {
+		log.Printf("serving secret %s", redactSecret(&list[index]))
+		secrets[index] = toSecret(&list[index])
+	}
+
+	return &pb.ListSecretsResponse{
+		Secrets: secrets,
+	}, nil
+}
+
+func (s *K8sService) WatchSecret(in *pb.WatchSecretRequest, stream pb.K8SService_WatchSecretServer) error {
+	ctx := stream.Context()
+
+	initial, events, err := s.manager.WatchSecrets(ctx, in.Names)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range initial {
+		log.Printf("streaming secret %s", redactSecret(secret))
+		if err := stream.Send(&pb.WatchSecretResponse{
+			Type:   pb.WatchEventType_INITIAL,
+			Secret: toSecret(secret),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return s.watchEnded(ctx)
+			}
+			secret := event.Object.(*v1.Secret)
+			log.Printf("streaming secret %s", redactSecret(secret))
+			if err := stream.Send(&pb.WatchSecretResponse{
+				Type:   watchEventType(event.Type),
+				Secret: toSecret(secret),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// redactSecret describes a Secret for logging without exposing any of its values
+func redactSecret(secret *v1.Secret) string {
+	keys := make([]string, 0, len(secret.Data))
+	for key, value := range secret.Data {
+		keys = append(keys, fmt.Sprintf("%s=<redacted %d bytes>", key, len(value)))
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%s@%s [%s]", secret.Name, secret.ResourceVersion, strings.Join(keys, " "))
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
+	"testing"
+)
+
+func TestRedactSecret(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", ResourceVersion: "42"},
+		Data: map[string][]byte{
+			"password": []byte("hunter2"),
+			"user":     []byte("admin"),
+		},
+	}
+
+	redacted := redactSecret(secret)
+	if strings.Contains(redacted, "hunter2") || strings.Contains(redacted, "admin") {
+		t.Errorf("secret value leaked: %s", redacted)
+	}
+	if expected := "db@42 [password=<redacted 7 bytes> user=<redacted 5 bytes>]"; redacted != expected {
+		t.Errorf("expected %q, got %q", expected, redacted)
+	}
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	jsonDecoder = jsonserializer.NewSerializerWithOptions(jsonserializer.DefaultMetaFactory, scheme.Scheme, scheme.Scheme, jsonserializer.SerializerOptions{Strict: true})
+	yamlDecoder = jsonserializer.NewSerializerWithOptions(jsonserializer.DefaultMetaFactory, scheme.Scheme, scheme.Scheme, jsonserializer.SerializerOptions{Yaml: true, Strict: true})
+)
+
+// decodeJobs decodes every Job document of a YAML or JSON template
+func decodeJobs(template string, format pb.TemplateFormat) ([]*batchv1.Job, error) {
+	objects, err := decodeTemplate(template, format, func() runtime.Object { return &batchv1.Job{} })
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*batchv1.Job, len(objects))
+	for index, object := range objects {
+		jobs[index] = object.(*batchv1.Job)
+	}
+	return jobs, nil
+}
+
+// decodeCronJobs decodes every CronJob document of a YAML or JSON template
+func decodeCronJobs(template string, format pb.TemplateFormat) ([]*batchv1.CronJob, error) {
+	objects, err := decodeTemplate(template, format, func() runtime.Object { return &batchv1.CronJob{} })
+	if err != nil {
+		return nil, err
+	}
+
+	cronJobs := make([]*batchv1.CronJob, len(objects))
+	for index, object := range objects {
+		cronJobs[index] = object.(*batchv1.CronJob)
+	}
+	return cronJobs, nil
+}
+
+// decodeTemplate splits a template into documents and strictly decodes each one into a new object.
+// Documents without apiVersion and kind are decoded as the expected type, any other type is rejected.
+// Every failure is reported as an InvalidArgument status.
+func decodeTemplate(template string, format pb.TemplateFormat, newObject func() runtime.Object) ([]runtime.Object, error) {
+	data := []byte(template)
+	if format == pb.TemplateFormat_AUTO {
+		format = pb.TemplateFormat_YAML
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+			format = pb.TemplateFormat_JSON
+		}
+	}
+
+	documents, err := splitDocuments(data, format)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template: %v", err)
+	}
+	if len(documents) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty template")
+	}
+
+	decoder := yamlDecoder
+	if format == pb.TemplateFormat_JSON {
+		decoder = jsonDecoder
+	}
+
+	objects := make([]runtime.Object, len(documents))
+	for index, document := range documents {
+		into := newObject()
+		expected, err := objectKind(into)
+		if err != nil {
+			return nil, err
+		}
+
+		object, actual, err := decoder.Decode(document, nil, into)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "document %d: %v", index, err)
+		}
+		if *actual != expected {
+			return nil, status.Errorf(codes.InvalidArgument, "document %d: expected %s, got %s", index, gvkString(expected), gvkString(*actual))
+		}
+		objects[index] = object
+	}
+	return objects, nil
+}
+
+// splitDocuments splits a stream of JSON objects or "---" separated YAML documents, dropping empty documents
+func splitDocuments(data []byte, format pb.TemplateFormat) ([][]byte, error) {
+	var documents [][]byte
+
+	if format == pb.TemplateFormat_JSON {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		for {
+			var document json.RawMessage
+			if err := decoder.Decode(&document); err == io.EOF {
+				return documents, nil
+			} else if err != nil {
+				return nil, err
+			}
+			documents = append(documents, document)
+		}
+	}
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// documents holding only comments or whitespace convert to null
+		converted, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, err
+		}
+		if string(converted) == "null" {
+			continue
+		}
+		documents = append(documents, document)
+	}
+}
+
+func objectKind(object runtime.Object) (schema.GroupVersionKind, error) {
+	kinds, _, err := scheme.Scheme.ObjectKinds(object)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	return kinds[0], nil
+}
+
+func gvkString(gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("%s %s", gvk.GroupVersion().String(), gvk.Kind)
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"github.com/Tlantic/k8s-sidecar/internal/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJobs(t *testing.T) {
+	t.Run("YAML", func(t *testing.T) {
+		jobs, err := decodeJobs(`
+# nightly import
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: import
+---
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: export
+`, pb.TemplateFormat_AUTO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs) != 2 || jobs[0].Name != "import" || jobs[1].Name != "export" {
+			t.Errorf("unexpected jobs %v", jobs)
+		}
+	})
+
+	t.Run("JSONStream", func(t *testing.T) {
+		jobs, err := decodeJobs(`{"metadata":{"name":"import"}} {"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"export"}}`, pb.TemplateFormat_AUTO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs) != 2 || jobs[0].Name != "import" || jobs[1].Name != "export" {
+			t.Errorf("unexpected jobs %v", jobs)
+		}
+	})
+
+	for name, test := range map[string]struct {
+		template string
+		format   pb.TemplateFormat
+		message  string
+	}{
+		"UnknownField": {"apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: import\nspec:\n  paralelism: 2\n", pb.TemplateFormat_YAML, "unknown field"},
+		"WrongKind":    {"apiVersion: batch/v1\nkind: CronJob\nmetadata:\n  name: import\n", pb.TemplateFormat_AUTO, "expected batch/v1 Job, got batch/v1 CronJob"},
+		"YAMLAsJSON":   {"metadata:\n  name: import\n", pb.TemplateFormat_JSON, "invalid template"},
+		"Empty":        {"# nothing here\n", pb.TemplateFormat_AUTO, "empty template"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeJobs(test.template, test.format)
+			if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("expected InvalidArgument containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestUnaryTracingInterceptor(t *testing.T) {
+	tracerProvider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetTextMapPropagator(propagator)
+	})
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"traceparent", "00-"+traceID+"-00f067aa0ba902b7-01",
+	))
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.K8sService/CreateJob"}
+
+	_, err := UnaryTracingInterceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if id := trace.SpanContextFromContext(ctx).TraceID().String(); id != traceID {
+			t.Errorf("expected handler in trace %s, got %s", traceID, id)
+		}
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	if span := spans[0]; span.Name() != "pb.K8sService/CreateJob" || span.SpanKind() != trace.SpanKindServer || span.Parent().TraceID().String() != traceID {
+		t.Errorf("unexpected span %s %s %v", span.Name(), span.SpanKind(), span.Parent())
+	}
+}